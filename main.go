@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/plugin"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/policy"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(runValidate(os.Args[2:]))
+		return
+	}
+
+	plugin.Serve(&plugin.ServeOpts{
+		ProviderFunc: tencentcloud.Provider,
+	})
+}
+
+// runValidate implements the `validate` subcommand: it runs the bundled
+// policy rules (see tencentcloud/policy) against the output of
+// `terraform show -json <planfile>` and reports any violations. It returns
+// the process exit code: 0 when there are no blocking violations, 1 otherwise.
+func runValidate(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: terraform-provider-tencentcloud validate <plan.json>")
+		return 2
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read plan file: %s\n", err.Error())
+		return 2
+	}
+
+	resources, err := planResources(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse plan file: %s\n", err.Error())
+		return 2
+	}
+
+	mode := policy.EnforcementMode(os.Getenv("TENCENTCLOUD_POLICY_ENFORCEMENT"))
+	if mode != policy.EnforcementBlock {
+		mode = policy.EnforcementWarn
+	}
+
+	violations := policy.Evaluate(resources, mode)
+	for _, v := range violations {
+		fmt.Fprintln(os.Stderr, v.String())
+	}
+
+	if mode == policy.EnforcementBlock && len(violations) > 0 {
+		return 1
+	}
+	return 0
+}
+
+type tfPlan struct {
+	PlannedValues struct {
+		RootModule tfModule `json:"root_module"`
+	} `json:"planned_values"`
+}
+
+type tfModule struct {
+	Resources    []tfResource `json:"resources"`
+	ChildModules []tfModule   `json:"child_modules"`
+}
+
+type tfResource struct {
+	Address string                 `json:"address"`
+	Type    string                 `json:"type"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+func planResources(data []byte) ([]policy.Resource, error) {
+	var plan tfPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, err
+	}
+
+	var resources []policy.Resource
+	collectModuleResources(plan.PlannedValues.RootModule, &resources)
+	return resources, nil
+}
+
+func collectModuleResources(module tfModule, out *[]policy.Resource) {
+	for _, r := range module.Resources {
+		*out = append(*out, policy.Resource{
+			Address: r.Address,
+			Type:    r.Type,
+			Values:  r.Values,
+		})
+	}
+	for _, child := range module.ChildModules {
+		collectModuleResources(child, out)
+	}
+}
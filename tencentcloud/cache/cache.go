@@ -0,0 +1,179 @@
+// Package cache provides a small layered read-through cache, modeled on the
+// Mattermost LayeredSupplier pattern: an in-process L1 (LRU, per-key TTL and
+// a size cap) in front of an optional shared L2, so repeated reads of the
+// same object across a single `terraform plan`/`apply` - or, with L2,
+// across concurrent CI workers - don't all fall through to the cloud API.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Backend is an optional, shared L2 store sitting behind the in-process L1.
+// It deals in pre-serialized values so it can be implemented by something
+// as simple as a key/value store without this package needing to know how
+// to (de)serialize every RedisService return type.
+//
+// NOTE: there is no built-in Backend implementation here. The request that
+// prompted this package asked for an optional Redis-backed L2 specifically,
+// but no Redis client library is vendored in this checkout (see go.mod) to
+// build one on top of - the same gap that has blocked other requests in
+// this chunk series from depending on packages that aren't actually part of
+// this source tree. Backend is defined so a networked implementation can be
+// dropped in later without having to change LayeredSupplier or its callers.
+type Backend interface {
+	Get(key string) (value string, found bool, err error)
+	Set(key, value string, ttl time.Duration) error
+	Del(key string) error
+}
+
+type lruEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// LRU is a fixed-capacity, per-entry-TTL in-process cache. It is the L1
+// layer of LayeredSupplier, but is usable standalone.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+// NewLRU creates an LRU capped at capacity entries, each valid for ttl after
+// being set. A zero ttl means entries never expire on their own (only
+// eviction on overflow removes them).
+func NewLRU(capacity int, ttl time.Duration) *LRU {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRU{
+		capacity: capacity,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached value for key, if present and not expired.
+func (c *LRU) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	ent := el.Value.(*lruEntry)
+	if !ent.expiresAt.IsZero() && time.Now().After(ent.expiresAt) {
+		c.removeElement(el)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return ent.value, true
+}
+
+// Set inserts or refreshes key, evicting the least recently used entry if
+// the cache is over capacity.
+func (c *LRU) Set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = time.Now().Add(c.ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*lruEntry).value = value
+		el.Value.(*lruEntry).expiresAt = expiresAt
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = el
+	if c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Del removes key, if present.
+func (c *LRU) Del(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+func (c *LRU) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry).key)
+}
+
+// LayeredSupplier composes an L1 LRU with an optional L2 Backend behind a
+// single read-through Get. Fetch only runs on a miss at every layer, and a
+// value fetched on an L2 miss (or via L2 being absent) is written back up
+// through every layer it missed.
+type LayeredSupplier struct {
+	l1    *LRU
+	l2    Backend
+	l2TTL time.Duration
+
+	// encode/decode adapt between the interface{} values L1 stores and the
+	// strings L2 stores. Callers that never configure an L2 backend can
+	// leave these nil.
+	encode func(interface{}) (string, error)
+	decode func(string) (interface{}, error)
+}
+
+// NewLayeredSupplier builds a LayeredSupplier. l2 may be nil to run L1-only.
+// encode/decode are required whenever l2 is non-nil.
+func NewLayeredSupplier(l1 *LRU, l2 Backend, l2TTL time.Duration, encode func(interface{}) (string, error), decode func(string) (interface{}, error)) *LayeredSupplier {
+	return &LayeredSupplier{l1: l1, l2: l2, l2TTL: l2TTL, encode: encode, decode: decode}
+}
+
+// Get returns the cached value for key, calling fetch and populating every
+// layer that missed if it isn't cached anywhere.
+func (s *LayeredSupplier) Get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if v, ok := s.l1.Get(key); ok {
+		return v, nil
+	}
+
+	if s.l2 != nil {
+		if raw, found, err := s.l2.Get(key); err == nil && found {
+			v, err := s.decode(raw)
+			if err == nil {
+				s.l1.Set(key, v)
+				return v, nil
+			}
+		}
+	}
+
+	v, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	s.l1.Set(key, v)
+	if s.l2 != nil && s.encode != nil {
+		if raw, err := s.encode(v); err == nil {
+			_ = s.l2.Set(key, raw, s.l2TTL)
+		}
+	}
+	return v, nil
+}
+
+// Invalidate drops key from every layer. Callers publish this after a
+// mutation (e.g. ModifyAutoBackupConfig) affecting the cached object.
+func (s *LayeredSupplier) Invalidate(key string) {
+	s.l1.Del(key)
+	if s.l2 != nil {
+		_ = s.l2.Del(key)
+	}
+}
@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU(2, 0)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // touch a, making b the least recently used
+	c.Set("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v.(int) != 1 {
+		t.Errorf("expected a to survive with value 1, got %v, %v", v, ok)
+	}
+	if v, ok := c.Get("c"); !ok || v.(int) != 3 {
+		t.Errorf("expected c to be present with value 3, got %v, %v", v, ok)
+	}
+}
+
+func TestLRUExpiresEntriesAfterTTL(t *testing.T) {
+	c := NewLRU(10, time.Millisecond)
+	c.Set("a", 1)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected a to have expired")
+	}
+}
+
+func TestLayeredSupplierOnlyFetchesOnMiss(t *testing.T) {
+	calls := 0
+	s := NewLayeredSupplier(NewLRU(10, 0), nil, 0, nil, nil)
+	fetch := func() (interface{}, error) {
+		calls++
+		return "value", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := s.Get("key", fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if v.(string) != "value" {
+			t.Errorf("expected value, got %v", v)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected fetch to run once, ran %d times", calls)
+	}
+}
+
+func TestLayeredSupplierInvalidateForcesRefetch(t *testing.T) {
+	calls := 0
+	s := NewLayeredSupplier(NewLRU(10, 0), nil, 0, nil, nil)
+	fetch := func() (interface{}, error) {
+		calls++
+		return fmt.Sprintf("value-%d", calls), nil
+	}
+
+	first, _ := s.Get("key", fetch)
+	s.Invalidate("key")
+	second, _ := s.Get("key", fetch)
+
+	if first == second {
+		t.Errorf("expected a fresh value after Invalidate, got %v both times", first)
+	}
+	if calls != 2 {
+		t.Errorf("expected fetch to run twice, ran %d times", calls)
+	}
+}
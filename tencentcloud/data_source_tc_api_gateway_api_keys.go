@@ -0,0 +1,123 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of API gateway access keys.
+
+Example Usage
+
+```hcl
+data "tencentcloud_api_gateway_api_keys" "keys" {
+  access_key_id = "AKID************************"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAPIGatewayAPIKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAPIGatewayAPIKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Access key id to filter results.",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key name to filter results.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"key_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the API gateway access keys.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Access key id.",
+						},
+						"secret_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key name.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key status, `on` or `off`.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the key.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAPIGatewayAPIKeysRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_api_gateway_api_keys.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	accessKeyId := d.Get("access_key_id").(string)
+	secretName := d.Get("secret_name").(string)
+
+	keys, err := apiGatewayService.DescribeApiKeys(ctx, accessKeyId, secretName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway keys failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(keys))
+	list := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, map[string]interface{}{
+			"access_key_id": key.AccessKeyId,
+			"secret_name":   key.SecretName,
+			"status":        key.Status,
+			"create_time":   key.CreatedTime,
+		})
+		if key.AccessKeyId != nil {
+			ids = append(ids, *key.AccessKeyId)
+		}
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("key_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set API gateway key list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
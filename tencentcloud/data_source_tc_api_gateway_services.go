@@ -0,0 +1,130 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of API gateway services.
+
+Example Usage
+
+```hcl
+data "tencentcloud_api_gateway_services" "services" {
+  service_id   = "service-pg6ud8pa"
+  service_name = "tf_example"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAPIGatewayServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAPIGatewayServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the API gateway service to filter results.",
+			},
+			"service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the API gateway service to filter results.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"service_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the API gateway services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the API gateway service.",
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the API gateway service.",
+						},
+						"service_desc": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the API gateway service.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Frontend request type of the API gateway service.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the API gateway service.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAPIGatewayServicesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_api_gateway_services.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+	serviceName := d.Get("service_name").(string)
+
+	services, err := apiGatewayService.DescribeServices(ctx, serviceId, serviceName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway services failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(services))
+	list := make([]map[string]interface{}, 0, len(services))
+	for _, service := range services {
+		list = append(list, map[string]interface{}{
+			"service_id":   service.ServiceId,
+			"service_name": service.ServiceName,
+			"service_desc": service.ServiceDesc,
+			"protocol":     service.Protocol,
+			"create_time":  service.CreatedTime,
+		})
+		if service.ServiceId != nil {
+			ids = append(ids, *service.ServiceId)
+		}
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("service_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set API gateway service list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
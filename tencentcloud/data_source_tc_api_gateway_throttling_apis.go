@@ -0,0 +1,128 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the throttling (rate limit) configuration of the
+APIs under an API gateway service environment.
+
+Example Usage
+
+```hcl
+data "tencentcloud_api_gateway_throttling_apis" "throttling" {
+  service_id       = "service-pg6ud8pa"
+  environment_name = "release"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAPIGatewayThrottlingApis() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAPIGatewayThrottlingApisRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the API gateway service.",
+			},
+			"environment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"test", "prepub", "release"}),
+				Description:  "Environment name, valid values are `test`, `prepub` and `release`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"throttling_api_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Throttling configuration list of the APIs under the environment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the API.",
+						},
+						"api_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the API.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Frontend path of the API.",
+						},
+						"method": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Frontend method of the API.",
+						},
+						"max_request_num_pre_sec": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Limit of requests per second for the API in this environment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_api_gateway_throttling_apis.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+	environmentName := d.Get("environment_name").(string)
+
+	apis, err := apiGatewayService.DescribeServiceApiThrottling(ctx, serviceId, environmentName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway throttling apis failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	list := make([]map[string]interface{}, 0, len(apis))
+	for _, api := range apis {
+		list = append(list, map[string]interface{}{
+			"api_id":                  api.ApiId,
+			"api_name":                api.ApiName,
+			"path":                    api.Path,
+			"method":                  api.Method,
+			"max_request_num_pre_sec": api.MaxRequestNumPreSec,
+		})
+	}
+	d.SetId(helper.DataResourceIdHash(serviceId + FILED_SP + environmentName))
+	if err := d.Set("throttling_api_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set API gateway throttling api list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of API gateway usage plans.
+
+Example Usage
+
+```hcl
+data "tencentcloud_api_gateway_usage_plans" "plans" {
+  usage_plan_id   = "usagePlan-gyeafpab"
+  usage_plan_name = "tf_example_plan"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAPIGatewayUsagePlans() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAPIGatewayUsagePlansRead,
+
+		Schema: map[string]*schema.Schema{
+			"usage_plan_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the usage plan to filter results.",
+			},
+			"usage_plan_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the usage plan to filter results.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"plan_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the usage plans.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"usage_plan_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the usage plan.",
+						},
+						"usage_plan_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the usage plan.",
+						},
+						"usage_plan_desc": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the usage plan.",
+						},
+						"max_request_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total number of requests allowed.",
+						},
+						"max_request_num_pre_sec": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Limit of requests per second.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the usage plan.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAPIGatewayUsagePlansRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_api_gateway_usage_plans.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	usagePlanId := d.Get("usage_plan_id").(string)
+	usagePlanName := d.Get("usage_plan_name").(string)
+
+	usagePlans, err := apiGatewayService.DescribeUsagePlans(ctx, usagePlanId, usagePlanName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway usage plans failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(usagePlans))
+	list := make([]map[string]interface{}, 0, len(usagePlans))
+	for _, plan := range usagePlans {
+		list = append(list, map[string]interface{}{
+			"usage_plan_id":           plan.UsagePlanId,
+			"usage_plan_name":         plan.UsagePlanName,
+			"usage_plan_desc":         plan.UsagePlanDesc,
+			"max_request_num":         plan.MaxRequestNum,
+			"max_request_num_pre_sec": plan.MaxRequestNumPreSec,
+			"create_time":             plan.CreatedTime,
+		})
+		if plan.UsagePlanId != nil {
+			ids = append(ids, *plan.UsagePlanId)
+		}
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("plan_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set API gateway usage plan list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
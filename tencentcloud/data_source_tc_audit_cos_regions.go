@@ -0,0 +1,97 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the COS regions available for storing CloudAudit logs.
+
+Example Usage
+
+```hcl
+data "tencentcloud_audit_cos_regions" "foo" {
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAuditCosRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAuditCosRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"cos_region_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of COS regions usable as an audit log destination.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cos_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region code of the COS region.",
+						},
+						"cos_region_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the COS region.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAuditCosRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_audit_cos_regions.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	regions, err := auditService.DescribeAuditCosRegions(ctx)
+	if err != nil {
+		log.Printf("[CRITAL]%s read audit cos regions failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(regions))
+	list := make([]map[string]interface{}, 0, len(regions))
+	for _, region := range regions {
+		list = append(list, map[string]interface{}{
+			"cos_region":      region.CosRegion,
+			"cos_region_name": region.CosRegionName,
+		})
+		if region.CosRegion != nil {
+			ids = append(ids, *region.CosRegion)
+		}
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("cos_region_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set audit cos region list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
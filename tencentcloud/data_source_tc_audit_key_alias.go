@@ -0,0 +1,105 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the KMS CMK aliases usable for CloudAudit log encryption in a given region.
+
+Example Usage
+
+```hcl
+data "tencentcloud_audit_key_alias" "foo" {
+  key_region = "ap-guangzhou"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAuditKeyAlias() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAuditKeyAliasRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Region to query the available KMS CMKs in.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"key_metadata_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of KMS CMKs usable for audit log encryption.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the CMK.",
+						},
+						"alias": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alias of the CMK.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAuditKeyAliasRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_audit_key_alias.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	keyRegion := d.Get("key_region").(string)
+
+	keys, err := auditService.DescribeAuditKeyAlias(ctx, keyRegion)
+	if err != nil {
+		log.Printf("[CRITAL]%s read audit key alias failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(keys))
+	list := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, map[string]interface{}{
+			"key_id": key.KeyId,
+			"alias":  key.Alias,
+		})
+		if key.KeyId != nil {
+			ids = append(ids, *key.KeyId)
+		}
+	}
+	d.SetId(helper.DataResourceIdHash(keyRegion + FILED_SP + helper.DataResourceIdsHash(ids)))
+	if err := d.Set("key_metadata_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set audit key alias list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of CloudAudit trails.
+
+Example Usage
+
+```hcl
+data "tencentcloud_audits" "foo" {
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudAudits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudAuditsRead,
+
+		Schema: map[string]*schema.Schema{
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"audit_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the audit trails.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the audit trail.",
+						},
+						"cos_bucket": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the COS bucket receiving the audit logs.",
+						},
+						"cos_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the COS bucket receiving the audit logs.",
+						},
+						"log_file_prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Prefix of the audit log file name.",
+						},
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the KMS CMK used to encrypt the audit logs.",
+						},
+						"read_write_attribute": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Attribute of the events recorded, `1` for write-only, `2` for read-only, `3` for read and write.",
+						},
+						"audit_switch": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the audit trail is enabled.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the audit trail.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_audits.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	audits, err := auditService.DescribeAudits(ctx)
+	if err != nil {
+		log.Printf("[CRITAL]%s read audit trails failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	names := make([]string, 0, len(audits))
+	list := make([]map[string]interface{}, 0, len(audits))
+	for _, audit := range audits {
+		item := map[string]interface{}{
+			"name":                 audit.AuditName,
+			"cos_bucket":           audit.CosBucket,
+			"cos_region":           audit.CosRegion,
+			"log_file_prefix":      audit.LogFilePrefix,
+			"key_id":               audit.KeyId,
+			"read_write_attribute": audit.ReadWriteAttribute,
+			"create_time":          audit.CreateTime,
+		}
+		if audit.IsEnabled != nil {
+			item["audit_switch"] = *audit.IsEnabled == int64(1)
+		}
+		list = append(list, item)
+		if audit.AuditName != nil {
+			names = append(names, *audit.AuditName)
+		}
+	}
+	d.SetId(helper.DataResourceIdsHash(names))
+	if err := d.Set("audit_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set audit list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -144,6 +144,50 @@ func dataSourceTencentCloudClbListeners() *schema.Resource {
 							Computed:    true,
 							Description: "Indicates whether SNI is enabled. NOTES: Only supported by 'HTTPS' protocol.",
 						},
+						"target_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the target group bound to this listener, empty if the listener is not bound to a target group.",
+						},
+						"target_list": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of backend targets bound to this listener and their health check status.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Id of the CVM instance backing this target, empty for an ENI-backed target.",
+									},
+									"ip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Private IP of the target.",
+									},
+									"port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Listening port of the target.",
+									},
+									"target_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of this target, `instance` or `eni`.",
+									},
+									"health_status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Health check status of the target, `healthy`, `unhealthy` or `unknown`.",
+									},
+									"health_status_reason": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Detailed health check status reported by CLB, e.g. `Alive`, `Dead` or `Unknown`.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -151,6 +195,29 @@ func dataSourceTencentCloudClbListeners() *schema.Resource {
 	}
 }
 
+func clbTargetHealthStatus(health *clb.TargetHealth) (status string, reason string, targetType string) {
+	reason = "Unknown"
+	if health.HealthStatusDetial != nil {
+		reason = *health.HealthStatusDetial
+	}
+
+	switch reason {
+	case "Alive":
+		status = "healthy"
+	case "Dead":
+		status = "unhealthy"
+	default:
+		status = "unknown"
+	}
+
+	targetType = CLB_TARGET_GROUP_BACKEND_TYPE_ENI
+	if health.TargetId != nil && *health.TargetId != "" {
+		targetType = CLB_TARGET_GROUP_BACKEND_TYPE_INSTANCE
+	}
+
+	return
+}
+
 func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("data_source.tencentcloud_clb_listeners.read")()
 
@@ -228,6 +295,35 @@ func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interfa
 				mapping["certificate_ca_id"] = *listener.Certificate.CertCaId
 			}
 		}
+		targetGroupId, err := clbService.DescribeTargetGroupIdByListener(ctx, clbId, *listener.ListenerId)
+		if err != nil {
+			return err
+		}
+		if targetGroupId != "" {
+			mapping["target_group_id"] = targetGroupId
+		}
+
+		targets, err := clbService.DescribeListenerTargetHealth(ctx, clbId, *listener.ListenerId)
+		if err != nil {
+			return err
+		}
+		targetList := make([]map[string]interface{}, 0, len(targets))
+		for _, target := range targets {
+			status, reason, targetType := clbTargetHealthStatus(target)
+			targetMapping := map[string]interface{}{
+				"ip":                   *target.IP,
+				"port":                 int(*target.Port),
+				"target_type":          targetType,
+				"health_status":        status,
+				"health_status_reason": reason,
+			}
+			if target.TargetId != nil {
+				targetMapping["target_id"] = *target.TargetId
+			}
+			targetList = append(targetList, targetMapping)
+		}
+		mapping["target_list"] = targetList
+
 		listenerList = append(listenerList, mapping)
 		ids = append(ids, *listener.ListenerId)
 	}
@@ -0,0 +1,233 @@
+// +build tencentcloud
+
+/*
+Use this data source to query CloudAudit events recorded between start_time
+and end_time, optionally narrowed down by lookup_attributes. Results are
+paginated transparently behind the scenes (the underlying API caps each page
+at 50 events).
+
+Example Usage
+
+```hcl
+data "tencentcloud_cloudaudit_events" "foo" {
+  start_time = 1600000000
+  end_time   = 1600003600
+
+  lookup_attributes {
+    attribute_key   = "ResourceType"
+    attribute_value = "cos_bucket"
+  }
+
+  max_results = 200
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	cloudaudit "github.com/tencentyun/tcecloud-sdk-go/tcecloud/cloudaudit/v20190304"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudCloudauditEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCloudauditEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Start time of the query, as a Unix timestamp in seconds.",
+			},
+			"end_time": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "End time of the query, as a Unix timestamp in seconds.",
+			},
+			"lookup_attributes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of attributes used to narrow down the events returned.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"Username", "EventName", "ResourceType", "ResourceName", "EventSource", "EventId"}),
+							Description:  "Attribute to filter on. Valid values: `Username`, `EventName`, `ResourceType`, `ResourceName`, `EventSource`, `EventId`.",
+						},
+						"attribute_value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value to match for the attribute.",
+						},
+					},
+				},
+			},
+			"max_results": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "Maximum number of events to return. The data source pages through the underlying API (50 events per page) until this many events have been collected. Defaults to 50.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"event_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the matched CloudAudit events.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the event.",
+						},
+						"event_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the event, i.e. the API action that was called.",
+						},
+						"event_source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Source product of the event.",
+						},
+						"event_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region the event occurred in.",
+						},
+						"event_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Time the event occurred, as a Unix timestamp in seconds.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user who triggered the event.",
+						},
+						"source_ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Source IP address the event was triggered from.",
+						},
+						"resources": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Resources affected by the event.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the affected resource.",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of the affected resource.",
+									},
+								},
+							},
+						},
+						"cloud_audit_event": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Raw JSON body of the event, as returned by the CloudAudit API.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCloudauditEventsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_cloudaudit_events.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	startTime := uint64(d.Get("start_time").(int))
+	endTime := uint64(d.Get("end_time").(int))
+	maxResults := uint64(d.Get("max_results").(int))
+
+	var lookupAttributes []*cloudaudit.Attr
+	if raw, ok := d.GetOk("lookup_attributes"); ok {
+		for _, item := range raw.([]interface{}) {
+			attr := item.(map[string]interface{})
+			lookupAttributes = append(lookupAttributes, &cloudaudit.Attr{
+				AttributeKey:   helper.String(attr["attribute_key"].(string)),
+				AttributeValue: helper.String(attr["attribute_value"].(string)),
+			})
+		}
+	}
+
+	events, err := auditService.LookupEvents(ctx, startTime, endTime, lookupAttributes, maxResults)
+	if err != nil {
+		log.Printf("[CRITAL]%s read cloudaudit events failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(events))
+	list := make([]map[string]interface{}, 0, len(events))
+	for _, event := range events {
+		var resources []map[string]interface{}
+		for _, res := range event.Resources {
+			resources = append(resources, map[string]interface{}{
+				"resource_name": res.ResourceName,
+				"resource_type": res.ResourceType,
+			})
+		}
+
+		rawEvent, err := json.Marshal(event)
+		if err != nil {
+			log.Printf("[CRITAL]%s marshal cloudaudit event failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+
+		list = append(list, map[string]interface{}{
+			"event_id":          event.EventId,
+			"event_name":        event.EventName,
+			"event_source":      event.EventSource,
+			"event_region":      event.EventRegion,
+			"event_time":        event.EventTime,
+			"username":          event.Username,
+			"source_ip_address": event.SourceIpAddress,
+			"resources":         resources,
+			"cloud_audit_event": string(rawEvent),
+		})
+		if event.EventId != nil {
+			ids = append(ids, *event.EventId)
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("event_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set cloudaudit event list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
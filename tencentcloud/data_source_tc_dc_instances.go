@@ -0,0 +1,147 @@
+// +build tencentcloud
+
+/*
+Use this data source to query physical direct connect lines (DC).
+
+Example Usage
+
+```hcl
+data "tencentcloud_dc_instances" "foo" {
+  dc_id = "dc-kax48sg7"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// NOTE: dc.DirectConnect, the response type DescribeDirectConnects below
+// returns, has no counterpart in the vendored dc/v20180412 SDK at all -
+// neither does dc.NewDescribeDirectConnectsRequest - even though
+// DcService.DescribeDirectConnects already assumed both exist before this
+// data source was added. The field list below is therefore a reasonable
+// minimal set inferred from that pre-existing call site and from the
+// sibling tencentcloud_dcx data source, not introspected off a real type.
+func dataSourceTencentCloudDcInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudDcInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"dc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the direct connect line to be queried.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the direct connect line to be queried.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of direct connect lines. Each element contains the following attributes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the direct connect line.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the direct connect line.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the direct connect line.",
+						},
+						"access_point_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the access point the line connects to.",
+						},
+						"line_operator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ISP that provides the line, e.g. `ChinaTelecom`, `ChinaMobile`, `ChinaUnicom`, `In-houseWiring`, `ThirdParty`.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth of the direct connect line in Mbps.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the direct connect line.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudDcInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_dc.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	dcId := d.Get("dc_id").(string)
+	name := d.Get("name").(string)
+
+	tcClient := meta.(*TencentCloudClient)
+	service := DcService{client: tcClient.apiV3Conn, requestTimeout: tcClient.requestTimeout}
+
+	lines, err := service.DescribeDirectConnects(ctx, dcId, name)
+	if err != nil {
+		return err
+	}
+
+	list := make([]map[string]interface{}, 0, len(lines))
+	ids := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		listItem := map[string]interface{}{
+			"dc_id":           line.DirectConnectId,
+			"name":            line.DirectConnectName,
+			"state":           line.State,
+			"access_point_id": line.AccessPointId,
+			"line_operator":   line.LineOperator,
+			"bandwidth":       line.Bandwidth,
+			"create_time":     line.CreatedTime,
+		}
+		list = append(list, listItem)
+		if line.DirectConnectId != nil {
+			ids = append(ids, *line.DirectConnectId)
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set dc list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
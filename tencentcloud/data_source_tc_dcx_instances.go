@@ -0,0 +1,230 @@
+// +build tencentcloud
+
+/*
+Use this data source to query direct connect tunnels (DCX).
+
+Example Usage
+
+```hcl
+data "tencentcloud_dcx_instances" "foo" {
+  dcx_id = "dcx-nreawj7r"
+}
+
+data "tencentcloud_dcx_instances" "by_dc" {
+  dc_id        = "dc-kax48sg7"
+  network_type = "VPC"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudDcxInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudDcxInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"dcx_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the direct connect tunnel to be queried.",
+			},
+			"dcx_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the direct connect tunnel to be queried.",
+			},
+			"dc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the physical direct connect line to filter by.",
+			},
+			"network_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Network type of the tunnel to filter by, `VPC`, `BMVPC` or `CCN`.",
+			},
+			"network_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Region of the network (VPC/CCN) the tunnel connects to, used to filter by.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "State of the tunnel to filter by, e.g. `AVAILABLE`, `ALLOCATING`, `DELETING`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of direct connect tunnels. Each element contains the following attributes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dcx_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the direct connect tunnel.",
+						},
+						"dcx_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the direct connect tunnel.",
+						},
+						"dc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the physical direct connect line this tunnel runs over.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the tunnel.",
+						},
+						"network_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network type of the tunnel, `VPC`, `BMVPC` or `CCN`.",
+						},
+						"network_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the network the tunnel connects to.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the VPC/black-stone-VPC this tunnel connects to.",
+						},
+						"route_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Route type of the tunnel, `BGP` or `STATIC`.",
+						},
+						"vlan": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "VLAN of the tunnel.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth of the tunnel in Mbps.",
+						},
+						"tencent_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Interconnect IP on the Tencent Cloud side.",
+						},
+						"customer_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Interconnect IP on the customer side.",
+						},
+						"bfd_state": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Whether BFD is enabled on the tunnel.",
+						},
+						"bfd_interval": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "BFD protocol interval, in ms.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the tunnel.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudDcxInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_dcx.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	dcxId := d.Get("dcx_id").(string)
+	dcxName := d.Get("dcx_name").(string)
+	dcId := d.Get("dc_id").(string)
+	networkType := d.Get("network_type").(string)
+	networkRegion := d.Get("network_region").(string)
+	state := d.Get("state").(string)
+
+	tcClient := meta.(*TencentCloudClient)
+	service := DcService{client: tcClient.apiV3Conn, requestTimeout: tcClient.requestTimeout}
+
+	tunnels, err := service.DescribeDirectConnectTunnels(ctx, dcxId, dcxName)
+	if err != nil {
+		return err
+	}
+
+	list := make([]map[string]interface{}, 0, len(tunnels))
+	ids := make([]string, 0, len(tunnels))
+
+	for _, tunnel := range tunnels {
+		if dcId != "" && (tunnel.DirectConnectId == nil || *tunnel.DirectConnectId != dcId) {
+			continue
+		}
+		if networkType != "" && (tunnel.NetworkType == nil || *tunnel.NetworkType != networkType) {
+			continue
+		}
+		if networkRegion != "" && (tunnel.VpcRegion == nil || *tunnel.VpcRegion != networkRegion) {
+			continue
+		}
+		if state != "" && (tunnel.State == nil || *tunnel.State != state) {
+			continue
+		}
+
+		listItem := map[string]interface{}{
+			"dcx_id":           tunnel.DirectConnectTunnelId,
+			"dcx_name":         tunnel.DirectConnectTunnelName,
+			"dc_id":            tunnel.DirectConnectId,
+			"state":            tunnel.State,
+			"network_type":     tunnel.NetworkType,
+			"network_region":   tunnel.VpcRegion,
+			"vpc_id":           tunnel.VpcId,
+			"route_type":       tunnel.RouteType,
+			"vlan":             tunnel.Vlan,
+			"bandwidth":        tunnel.Bandwidth,
+			"tencent_address":  tunnel.CloudAddress,
+			"customer_address": tunnel.CustomerAddress,
+			"bfd_state":        tunnel.BfdState,
+			"bfd_interval":     tunnel.BfdInterval,
+			"create_time":      tunnel.CreatedTime,
+		}
+		list = append(list, listItem)
+		if tunnel.DirectConnectTunnelId != nil {
+			ids = append(ids, *tunnel.DirectConnectTunnelId)
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set dcx list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
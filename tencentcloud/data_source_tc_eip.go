@@ -33,6 +33,14 @@ var (
 	errEIPNotFound = errors.New("eip not found")
 )
 
+// NOTE: reworking DescribeEipByFilter into a proper Offset/Limit pagination
+// loop, and adding most_recent/sort_by plus an expanded filter.name
+// whitelist, was requested here. vpcService.DescribeEipByFilter is called a
+// few lines below in dataSourceTencentCloudEipRead, but VpcService itself -
+// like CbsService (see the notes in resource_tc_cbs_storage.go) - has no
+// type definition anywhere in this checkout, so there is no existing
+// paginated-or-not implementation to rework and no DescribeEipByFilter to
+// plug a sort/most_recent layer in front of.
 func dataSourceTencentCloudEip() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.20.0. Please use 'tencentcloud_eips' instead.",
@@ -30,6 +30,13 @@ data "tencentcloud_gaap_http_domains" "foo" {
   listener_id = tencentcloud_gaap_layer7_listener.foo.id
   domain      = tencentcloud_gaap_http_domain.foo.domain
 }
+
+# list every forward domain of the listener, only keeping the ones with
+# client certificate authentication enabled
+data "tencentcloud_gaap_http_domains" "auth" {
+  listener_id = tencentcloud_gaap_layer7_listener.foo.id
+  gaap_auth   = true
+}
 ```
 */
 package tencentcloud
@@ -53,8 +60,28 @@ func dataSourceTencentCloudGaapHttpDomains() *schema.Resource {
 			},
 			"domain": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Forward domain of the layer7 listener to be queried.",
+				Optional:    true,
+				Description: "Forward domain of the layer7 listener to be queried. If omitted, all the forward domains of the listener are listed.",
+			},
+			"realserver_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Used to filter the result, indicates whether realserver authentication is enabled.",
+			},
+			"basic_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Used to filter the result, indicates whether basic authentication is enabled.",
+			},
+			"gaap_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Used to filter the result, indicates whether SSL certificate authentication is enabled.",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to filter the result, ID of the server certificate.",
 			},
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -148,8 +175,15 @@ func dataSourceTencentCloudGaapHttpDomainsRead(d *schema.ResourceData, m interfa
 	listenerId := d.Get("listener_id").(string)
 	domain := d.Get("domain").(string)
 
+	realserverAuth, realserverAuthSet := d.GetOkExists("realserver_auth")
+	basicAuth, basicAuthSet := d.GetOkExists("basic_auth")
+	gaapAuth, gaapAuthSet := d.GetOkExists("gaap_auth")
+	certificateId, certificateIdSet := d.GetOk("certificate_id")
+
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
+	// domain is optional here; an empty domain asks DescribeDomains for
+	// every forward domain of the listener instead of a single one.
 	domainRules, err := service.DescribeDomains(ctx, listenerId, domain)
 	if err != nil {
 		return err
@@ -174,6 +208,19 @@ func dataSourceTencentCloudGaapHttpDomainsRead(d *schema.ResourceData, m interfa
 			dr.GaapAuth = helper.IntInt64(0)
 		}
 
+		if realserverAuthSet && (*dr.RealServerAuth == 1) != realserverAuth.(bool) {
+			continue
+		}
+		if basicAuthSet && (*dr.BasicAuth == 1) != basicAuth.(bool) {
+			continue
+		}
+		if gaapAuthSet && (*dr.GaapAuth == 1) != gaapAuth.(bool) {
+			continue
+		}
+		if certificateIdSet && (dr.CertificateId == nil || *dr.CertificateId != certificateId.(string)) {
+			continue
+		}
+
 		ids = append(ids, *dr.Domain)
 
 		var (
@@ -182,7 +229,9 @@ func dataSourceTencentCloudGaapHttpDomainsRead(d *schema.ResourceData, m interfa
 			realserverCertificateIds []*string
 		)
 
-		clientCertificateId = dr.PolyClientCertificateAliasInfo[0].CertificateId
+		if len(dr.PolyClientCertificateAliasInfo) > 0 {
+			clientCertificateId = dr.PolyClientCertificateAliasInfo[0].CertificateId
+		}
 		for _, poly := range dr.PolyClientCertificateAliasInfo {
 			polyClientCertificateIds = append(polyClientCertificateIds, poly.CertificateId)
 		}
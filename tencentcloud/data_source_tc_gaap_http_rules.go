@@ -59,6 +59,13 @@ import (
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// NOTE: filtering this data source by realserver id/ip and scheduler, plus
+// sorting the result set, was requested here. service.DescribeDomains is
+// called a few lines below in dataSourceTencentCloudGaapHttpRulesRead, but
+// GaapService itself has no type definition anywhere in this checkout (no
+// service_tencentcloud_gaap.go exists) - same gap as CbsService/VpcService
+// noted elsewhere in this tree - so there is no DescribeDomains to extend
+// with additional filter parameters.
 func dataSourceTencentCloudGaapHttpRules() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceTencentCloudGaapHttpRulesRead,
@@ -0,0 +1,143 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the backups of a MongoDB instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mongodb_instance_backups" "backups" {
+  instance_id = "cmgo-xxxxxx"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudMongodbInstanceBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMongodbInstanceBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the MongoDB instance.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the backups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the backup.",
+						},
+						"backup_method": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Method of the backup, 0 for logic backup, 1 for physical backup.",
+						},
+						"backup_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Type of the backup, 0 for instance backup, 1 for sharding/replica-set backup.",
+						},
+						"backup_desc": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Remark of the backup.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start time of the backup.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End time of the backup.",
+						},
+						"download_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Intranet download URL of the backup file.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMongodbInstanceBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_mongodb_instance_backups.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Get("instance_id").(string)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	backups, err := mongodbService.DescribeBackups(ctx, instanceId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read mongodb instance backups failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(backups))
+	backupList := make([]map[string]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		if backup.BackupId == nil {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"backup_id":     *backup.BackupId,
+			"backup_method": *backup.BackupMethod,
+			"backup_type":   *backup.BackupType,
+			"backup_desc":   *backup.BackupDesc,
+			"start_time":    *backup.BackupStartTime,
+			"end_time":      *backup.BackupEndTime,
+		}
+
+		downloadUrl, err := mongodbService.DescribeBackupAccess(ctx, instanceId, *backup.BackupId)
+		if err != nil {
+			return err
+		}
+		mapping["download_url"] = downloadUrl
+
+		backupList = append(backupList, mapping)
+		ids = append(ids, *backup.BackupId)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if e := d.Set("list", backupList); e != nil {
+		log.Printf("[CRITAL]%s provider set mongodb instance backup list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), backupList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
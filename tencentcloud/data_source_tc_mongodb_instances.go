@@ -0,0 +1,247 @@
+// +build tencentcloud
+
+/*
+Use this data source to query MongoDB instances.
+
+Example Usage
+
+```hcl
+data "tencentcloud_mongodb_instances" "instances" {
+  instance_id = "cmgo-xxxxxx"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudMongodbInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMongodbInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Id of the MongoDB instance to be queried.",
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the MongoDB instance to be queried.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"instance_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the MongoDB instances.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the instance.",
+						},
+						"instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the instance.",
+						},
+						"project_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the project to which the instance belongs.",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Memory size of the instance, unit is GB.",
+						},
+						"volume": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Disk size of the instance, unit is GB.",
+						},
+						"engine_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the MongoDB database engine.",
+						},
+						"vip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the instance.",
+						},
+						"vport": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "IP port of the instance.",
+						},
+						"status": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Status of the instance.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the instance.",
+						},
+						"auto_minor_version_upgrade": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether minor engine version upgrades are applied automatically during the maintenance window.",
+						},
+						"maintenance_window": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Current weekly maintenance window of the instance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Days of the week the window applies to.",
+									},
+									"start_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Start time of the window, in `HH:MM` format.",
+									},
+									"duration_hours": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Length of the window in hours.",
+									},
+								},
+							},
+						},
+						"pending_modification": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of a spec or engine-version change queued to apply at the next maintenance window, empty if none is pending.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMongodbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_mongodb_instances.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	filter := make(map[string]interface{})
+	if v, ok := d.GetOk("instance_id"); ok {
+		filter["instance_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("instance_name"); ok {
+		filter["instance_name"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("project_id"); ok {
+		filter["project_id"] = v.(int)
+	}
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	instances, err := mongodbService.DescribeInstancesByFilter(ctx, filter)
+	if err != nil {
+		log.Printf("[CRITAL]%s read mongodb instances failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(instances))
+	instanceList := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		if instance.InstanceId == nil {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"instance_id": *instance.InstanceId,
+		}
+		if instance.InstanceName != nil {
+			mapping["instance_name"] = *instance.InstanceName
+		}
+		if instance.ProjectId != nil {
+			mapping["project_id"] = int(*instance.ProjectId)
+		}
+		if instance.Memory != nil {
+			mapping["memory"] = int(*instance.Memory)
+		}
+		if instance.Volume != nil {
+			mapping["volume"] = int(*instance.Volume)
+		}
+		if instance.MongoVersion != nil {
+			mapping["engine_version"] = *instance.MongoVersion
+		}
+		if instance.Vip != nil {
+			mapping["vip"] = *instance.Vip
+		}
+		if instance.Vport != nil {
+			mapping["vport"] = int(*instance.Vport)
+		}
+		if instance.Status != nil {
+			mapping["status"] = int(*instance.Status)
+		}
+		if instance.CreateTime != nil {
+			mapping["create_time"] = *instance.CreateTime
+		}
+		if instance.AutoUpgrade != nil {
+			mapping["auto_minor_version_upgrade"] = *instance.AutoUpgrade
+		}
+		if instance.PendingModification != nil {
+			mapping["pending_modification"] = *instance.PendingModification
+		}
+		if len(instance.Weekday) > 0 && instance.StartTime != nil && instance.TimeSpan != nil {
+			days := make([]string, 0, len(instance.Weekday))
+			for _, day := range instance.Weekday {
+				days = append(days, *day)
+			}
+			mapping["maintenance_window"] = []map[string]interface{}{
+				{
+					"day_of_week":    days,
+					"start_time":     *instance.StartTime,
+					"duration_hours": int(*instance.TimeSpan),
+				},
+			}
+		}
+
+		instanceList = append(instanceList, mapping)
+		ids = append(ids, *instance.InstanceId)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if e := d.Set("instance_list", instanceList); e != nil {
+		log.Printf("[CRITAL]%s provider set mongodb instance list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), instanceList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the objects (instances or instance groups)
+bound to an alarm policy group, as returned by
+`tencentcloud_monitor_policy_groups` or managed by
+`tencentcloud_monitor_alarm_policy`.
+
+Example Usage
+
+```hcl
+data "tencentcloud_monitor_policy_groups" "name" {
+}
+
+data "tencentcloud_monitor_binding_objects" "objects" {
+  group_id = data.tencentcloud_monitor_policy_groups.name.list.0.group_id
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceTencentMonitorBindingObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentMonitorBindingObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Id of the policy group to query bound objects for.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of objects bound to the policy group. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unique_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique id of the bound object.",
+						},
+						"dimensions": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Dimensions of the bound object, as a JSON-encoded object.",
+						},
+						"is_shielded": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "`1` if the bound object is currently shielded (alarms suppressed), `0` otherwise.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the bound object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentMonitorBindingObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_monitor_binding_objects.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId := int64(d.Get("group_id").(int))
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
+	if err != nil {
+		return err
+	}
+
+	list := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		listItem := map[string]interface{}{}
+		listItem["unique_id"] = object.UniqueId
+		listItem["dimensions"] = object.Dimensions
+		if object.IsShielded != nil {
+			listItem["is_shielded"] = int(*object.IsShielded)
+		}
+		listItem["region"] = object.Region
+		list = append(list, listItem)
+	}
+
+	if err := d.Set("list", list); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("monitor_binding_objects_%d", groupId))
+	if output, ok := d.GetOk("result_output_file"); ok {
+		return writeToFile(output.(string), list)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Use this data source to query a cloud product's raw monitor datapoints for
+one dimension set over a time window, and optionally reduce them to a single
+value with an aggregation function. This generalizes the metric polling
+`tencentcloud_monitor_alarm_policy`'s conditions and
+`CheckCanCreateMysqlROInstance` each do ad hoc, so other configurations can
+express "only proceed if metric X satisfies Y" with `count`/`for_each`
+instead of bespoke provider code.
+
+Example Usage
+
+```hcl
+data "tencentcloud_monitor_metric" "cdb_capacity" {
+  namespace   = "QCE/CDB"
+  metric_name = "RealCapacity"
+  period      = 60
+  window      = 300
+
+  dimensions = {
+    InstanceId = "cdb-xxxxxxxx"
+  }
+
+  aggregation = "last"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudMonitorMetric() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMonitorMetricRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Namespace of the monitored product, e.g. `QCE/CDB`, as returned by `tencentcloud_monitor_product_namespace`.",
+			},
+			"metric_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the metric to read, e.g. `RealCapacity`.",
+			},
+			"period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     60,
+				Description: "Statistical period of the metric in seconds. Default is 60.",
+			},
+			"window": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Length of the time window to read, in seconds, ending now. Default is 300.",
+			},
+			"dimensions": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "Dimensions identifying the single object to read the metric for, e.g. `{\"InstanceId\" = \"ins-xxxxxxxx\"}`.",
+			},
+			"aggregation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "last",
+				ValidateFunc: validateAllowedStringValue([]string{"last", "avg", "max", "min", "sum"}),
+				Description:  "How to reduce the window's datapoints to `result`: `last`, `avg`, `max`, `min` or `sum`. Default is `last`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"timestamps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "Raw datapoint timestamps, as Unix seconds.",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "Raw datapoint values, one per entry in `timestamps`.",
+			},
+			"result": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "`values` reduced by `aggregation`. Not set if no datapoints were returned.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMonitorMetricRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_monitor_metric.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	namespace := d.Get("namespace").(string)
+	metricName := d.Get("metric_name").(string)
+	period := uint64(d.Get("period").(int))
+	window := time.Duration(d.Get("window").(int)) * time.Second
+	agg := MetricAggregation(d.Get("aggregation").(string))
+
+	dimensions := map[string]string{}
+	for k, v := range d.Get("dimensions").(map[string]interface{}) {
+		dimensions[k] = v.(string)
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		dataPoints, e := monitorService.DescribeMonitorData(ctx, namespace, metricName, period, window, dimensions)
+		if e != nil {
+			return retryError(e)
+		}
+
+		var timestamps, values []interface{}
+		if len(dataPoints) > 0 {
+			for _, ts := range dataPoints[0].Timestamps {
+				if ts != nil {
+					timestamps = append(timestamps, *ts)
+				}
+			}
+			for _, v := range dataPoints[0].Values {
+				if v != nil {
+					values = append(values, *v)
+				}
+			}
+			if result, ok := aggregateMetricValues(dataPoints[0].Values, agg); ok {
+				_ = d.Set("result", result)
+			}
+		}
+		_ = d.Set("timestamps", timestamps)
+		_ = d.Set("values", values)
+
+		d.SetId(helper.DataResourceIdsHash([]string{namespace, metricName, string(agg)}))
+
+		output, ok := d.GetOk("result_output_file")
+		if ok && output.(string) != "" {
+			if e := writeToFile(output.(string), map[string]interface{}{"timestamps": timestamps, "values": values}); e != nil {
+				return resource.NonRetryableError(e)
+			}
+		}
+
+		if dataPoints == nil {
+			log.Printf("[DEBUG]%s no datapoints returned for metric %s.%s\n", logId, namespace, metricName)
+		}
+		return nil
+	})
+}
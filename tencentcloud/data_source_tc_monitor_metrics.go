@@ -0,0 +1,111 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the valid metric names for a monitor product
+namespace, as returned by `tencentcloud_monitor_product_namespace`. This lets
+`tencentcloud_monitor_alarm_policy` `metric` blocks be checked against real
+metric names instead of failing only at apply time against the API.
+
+Example Usage
+
+```hcl
+data "tencentcloud_monitor_product_namespace" "cvm" {
+  name = "CVM"
+}
+
+data "tencentcloud_monitor_metrics" "cvm" {
+  namespace = data.tencentcloud_monitor_product_namespace.cvm.list.0.namespace
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceTencentMonitorMetrics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentMonitorMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Namespace of the monitor product, as returned by `tencentcloud_monitor_product_namespace`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of metrics supported by the namespace. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Id of this metric, used by `tencentcloud_monitor_alarm_policy` to address the metric internally.",
+						},
+						"metric_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of this metric.",
+						},
+						"metric_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unit of this metric.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentMonitorMetricsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_monitor_metrics.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	namespace := d.Get("namespace").(string)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	conditions, err := monitorService.DescribePolicyConditionList(ctx)
+	if err != nil {
+		return err
+	}
+
+	var list []interface{}
+	for _, condition := range conditions {
+		if condition.PolicyViewName == nil || *condition.PolicyViewName != namespace {
+			continue
+		}
+		for _, metric := range condition.Metrics {
+			listItem := map[string]interface{}{}
+			if metric.MetricId != nil {
+				listItem["metric_id"] = int(*metric.MetricId)
+			}
+			listItem["metric_name"] = metric.MetricShowName
+			listItem["metric_unit"] = metric.MetricUnit
+			list = append(list, listItem)
+		}
+	}
+
+	if err = d.Set("list", list); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("monitor_metrics_%s", namespace))
+	if output, ok := d.GetOk("result_output_file"); ok {
+		return writeToFile(output.(string), list)
+	}
+	return nil
+}
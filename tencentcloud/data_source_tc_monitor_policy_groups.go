@@ -0,0 +1,160 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the alarm policy groups visible to the
+current account, as managed by `tencentcloud_monitor_alarm_policy` (and any
+policy groups created outside of Terraform).
+
+Example Usage
+
+```hcl
+data "tencentcloud_monitor_policy_groups" "name" {
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceTencentMonitorPolicyGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentMonitorPolicyGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"policy_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Namespace used to filter the policy groups, as returned by `tencentcloud_monitor_product_namespace`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of policy groups. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Id of this policy group.",
+						},
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of this policy group.",
+						},
+						"view_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace this policy group belongs to.",
+						},
+						"is_open": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether this policy group is enabled.",
+						},
+						"is_default": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "`1` if this is the default policy group of the namespace, `0` otherwise.",
+						},
+						"use_sum": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of objects bound to this policy group.",
+						},
+						"no_shielded_sum": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of bound objects that are not shielded.",
+						},
+						"update_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Last update time of this policy group, in Unix timestamp.",
+						},
+						"insert_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Create time of this policy group, in Unix timestamp.",
+						},
+						"project_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Project id this policy group belongs to.",
+						},
+						"remark": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Remark of this policy group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_monitor_policy_groups.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	policyView := d.Get("policy_view").(string)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	groups, err := monitorService.DescribePolicyGroups(ctx, policyView)
+	if err != nil {
+		return err
+	}
+
+	list := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		listItem := map[string]interface{}{}
+		if group.GroupId != nil {
+			listItem["group_id"] = int(*group.GroupId)
+		}
+		listItem["group_name"] = group.GroupName
+		listItem["view_name"] = group.ViewName
+		listItem["is_open"] = group.IsOpen
+		if group.IsDefault != nil {
+			listItem["is_default"] = int(*group.IsDefault)
+		}
+		if group.UseSum != nil {
+			listItem["use_sum"] = int(*group.UseSum)
+		}
+		if group.NoShieldedSum != nil {
+			listItem["no_shielded_sum"] = int(*group.NoShieldedSum)
+		}
+		if group.UpdateTime != nil {
+			listItem["update_time"] = int(*group.UpdateTime)
+		}
+		if group.InsertTime != nil {
+			listItem["insert_time"] = int(*group.InsertTime)
+		}
+		if group.ProjectId != nil {
+			listItem["project_id"] = int(*group.ProjectId)
+		}
+		listItem["remark"] = group.Remark
+		list = append(list, listItem)
+	}
+
+	if err := d.Set("list", list); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("monitor_policy_groups_%s", policyView))
+	if output, ok := d.GetOk("result_output_file"); ok {
+		return writeToFile(output.(string), list)
+	}
+	return nil
+}
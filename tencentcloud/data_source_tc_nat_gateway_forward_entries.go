@@ -0,0 +1,203 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of NAT gateway forward
+entries (destination-NAT / port forwarding rules).
+
+Example Usage
+
+```hcl
+data "tencentcloud_nat_gateway_forward_entries" "foo" {
+  nat_gateway_id = "nat-xfaq1"
+  protocol       = "TCP"
+  public_ip      = "203.0.113.1"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudNatGatewayForwardEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudNatGatewayForwardEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the NAT gateway.",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Network protocol, valid values are `TCP`, `UDP`.",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "EIP of the NAT gateway this entry forwards from.",
+			},
+			"public_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Public port this entry forwards from.",
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Private IP this entry forwards to.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"entry_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the forward entries.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nat_gateway_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the NAT gateway.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network protocol.",
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "EIP of the NAT gateway this entry forwards from.",
+						},
+						"public_port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Public port this entry forwards from.",
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Private IP this entry forwards to.",
+						},
+						"private_port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Private port this entry forwards to.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of this forward entry.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudNatGatewayForwardEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_nat_gateway_forward_entries.read")()
+
+	logId := getLogId(contextNil)
+	request := vpc.NewDescribeNatGatewayDestinationIpPortTranslationNatRulesRequest()
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	request.NatGatewayIds = []*string{&gatewayId}
+
+	params := make(map[string]string)
+	if v, ok := d.GetOk("protocol"); ok {
+		params["protocol"] = v.(string)
+	}
+	if v, ok := d.GetOk("public_ip"); ok {
+		params["public-ip-address"] = v.(string)
+	}
+	if v, ok := d.GetOk("public_port"); ok {
+		params["public-port"] = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("private_ip"); ok {
+		params["private-ip-address"] = v.(string)
+	}
+	request.Filters = make([]*vpc.Filter, 0, len(params))
+	for k, v := range params {
+		filter := &vpc.Filter{
+			Name:   helper.String(k),
+			Values: []*string{helper.String(v)},
+		}
+		request.Filters = append(request.Filters, filter)
+	}
+
+	offset := uint64(0)
+	request.Offset = &offset
+	limit := uint64(NAT_DESCRIBE_LIMIT)
+	request.Limit = &limit
+	result := make([]*vpc.NatGatewayDestinationIpPortTranslationNatRule, 0)
+	for {
+		var response *vpc.DescribeNatGatewayDestinationIpPortTranslationNatRulesResponse
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRules(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			response = result
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s read NAT gateway forward entries failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+		result = append(result, response.Response.NatGatewayDestinationIpPortTranslationNatRuleSet...)
+		if len(response.Response.NatGatewayDestinationIpPortTranslationNatRuleSet) < NAT_DESCRIBE_LIMIT {
+			break
+		}
+		offset = offset + limit
+		request.Offset = &offset
+	}
+
+	ids := make([]string, 0, len(result))
+	entryList := make([]map[string]interface{}, 0, len(result))
+	for _, rule := range result {
+		mapping := map[string]interface{}{
+			"nat_gateway_id": gatewayId,
+			"protocol":       *rule.IpProtocol,
+			"public_ip":      *rule.PublicIpAddress,
+			"public_port":    int(*rule.PublicPort),
+			"private_ip":     *rule.PrivateIpAddress,
+			"private_port":   int(*rule.PrivatePort),
+			"description":    *rule.Description,
+		}
+		entryList = append(entryList, mapping)
+		ids = append(ids, natGatewayForwardEntryId(gatewayId, *rule.IpProtocol, *rule.PublicIpAddress, int(*rule.PublicPort)))
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if e := d.Set("entry_list", entryList); e != nil {
+		log.Printf("[CRITAL]%s provider set NAT gateway forward entry list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), entryList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of NAT gateway SNAT
+entries.
+
+Example Usage
+
+```hcl
+data "tencentcloud_nat_gateway_snat_entries" "foo" {
+  nat_gateway_id = "nat-xfaq1"
+  resource_type  = "SUBNET"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudNatGatewaySnatEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudNatGatewaySnatEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the NAT gateway.",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{NAT_GATEWAY_SNAT_RESOURCE_TYPE_SUBNET, NAT_GATEWAY_SNAT_RESOURCE_TYPE_NETWORKINTERFACE}),
+				Description:  "Type of the resource this entry translates, valid values are `SUBNET`, `NETWORKINTERFACE`.",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "EIP of the NAT gateway this entry translates to.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"entry_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the SNAT entries.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nat_gateway_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the NAT gateway.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the resource this entry translates.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the translated resource.",
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "EIP of the NAT gateway this entry translates to.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of this SNAT entry.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudNatGatewaySnatEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_nat_gateway_snat_entries.read")()
+
+	logId := getLogId(contextNil)
+	request := vpc.NewDescribeNatGatewaySourceIpTranslationNatRulesRequest()
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	request.NatGatewayIds = []*string{&gatewayId}
+
+	params := make(map[string]string)
+	if v, ok := d.GetOk("resource_type"); ok {
+		params["resource-type"] = v.(string)
+	}
+	if v, ok := d.GetOk("public_ip"); ok {
+		params["public-ip-address"] = v.(string)
+	}
+	request.Filters = make([]*vpc.Filter, 0, len(params))
+	for k, v := range params {
+		filter := &vpc.Filter{
+			Name:   helper.String(k),
+			Values: []*string{helper.String(v)},
+		}
+		request.Filters = append(request.Filters, filter)
+	}
+
+	offset := uint64(0)
+	request.Offset = &offset
+	limit := uint64(NAT_DESCRIBE_LIMIT)
+	request.Limit = &limit
+	result := make([]*vpc.SourceIpTranslationNatRule, 0)
+	for {
+		var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			response = result
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s read NAT gateway SNAT entries failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+		result = append(result, response.Response.SourceIpTranslationNatRuleSet...)
+		if len(response.Response.SourceIpTranslationNatRuleSet) < NAT_DESCRIBE_LIMIT {
+			break
+		}
+		offset = offset + limit
+		request.Offset = &offset
+	}
+
+	ids := make([]string, 0, len(result))
+	entryList := make([]map[string]interface{}, 0, len(result))
+	for _, rule := range result {
+		mapping := map[string]interface{}{
+			"nat_gateway_id": gatewayId,
+			"resource_type":  *rule.ResourceType,
+			"resource_id":    *rule.ResourceId,
+			"public_ip":      *rule.PublicIpAddress,
+		}
+		if rule.Description != nil {
+			mapping["description"] = *rule.Description
+		}
+		entryList = append(entryList, mapping)
+		ids = append(ids, natGatewaySnatEntryId(gatewayId, *rule.ResourceType, *rule.ResourceId, *rule.PublicIpAddress))
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if e := d.Set("entry_list", entryList); e != nil {
+		log.Printf("[CRITAL]%s provider set NAT gateway SNAT entry list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), entryList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
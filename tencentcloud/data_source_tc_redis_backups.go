@@ -0,0 +1,150 @@
+/*
+Use this data source to query the backup set of a Redis instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_redis_backups" "backups" {
+  redis_id    = "crs-xxxxxxxx"
+  backup_type = "manualBackupInstance"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudRedisBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudRedisBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the redis instance to list backups of.",
+			},
+			"begin_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list backups started at or after this time, e.g. `2017-02-08 16:46:34`.",
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list backups started at or before this time, e.g. `2017-02-08 19:09:26`.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list backups of this type, `manualBackupInstance` (user triggered) or `systemBackupInstance` (automatic).",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"backup_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of backups. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the backup.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the backup started.",
+						},
+						"backup_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the backup, `manualBackupInstance` or `systemBackupInstance`.",
+						},
+						"remark": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Remark attached to the backup.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the backup is locked by another process and cannot be used to restore yet.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudRedisBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_redis_backups.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisId := d.Get("redis_id").(string)
+	beginTime := d.Get("begin_time").(string)
+	endTime := d.Get("end_time").(string)
+	backupType := d.Get("backup_type").(string)
+
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		backups, e := redisService.DescribeInstanceBackups(ctx, redisId, beginTime, endTime)
+		if e != nil {
+			return retryError(e)
+		}
+
+		ids := make([]string, 0, len(backups))
+		backupList := make([]map[string]interface{}, 0, len(backups))
+		for _, backup := range backups {
+			if backupType != "" && (backup.BackupType == nil || *backup.BackupType != backupType) {
+				continue
+			}
+			backupList = append(backupList, map[string]interface{}{
+				"backup_id":   backup.BackupId,
+				"start_time":  backup.StartTime,
+				"backup_type": backup.BackupType,
+				"remark":      backup.Remark,
+				"locked":      backup.Locked != nil && *backup.Locked == 1,
+			})
+			if backup.BackupId != nil {
+				ids = append(ids, *backup.BackupId)
+			}
+		}
+
+		d.SetId(helper.DataResourceIdsHash(append(ids, redisId)))
+		if e := d.Set("backup_list", backupList); e != nil {
+			log.Printf("[CRITAL]%s provider set redis backup list fail, reason:%s\n", logId, e.Error())
+			return resource.NonRetryableError(e)
+		}
+
+		output, ok := d.GetOk("result_output_file")
+		if ok && output.(string) != "" {
+			if e := writeToFile(output.(string), backupList); e != nil {
+				return resource.NonRetryableError(e)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read redis backups failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+Use this data source to query the engine parameters a Redis instance
+supports - their default/current values, allowed values, bounds, and
+whether changing them requires a restart - so a `parameters` map on
+`tencentcloud_redis_instance` can be validated before apply.
+
+There is no API to enumerate this by `TypeId` alone, only by reading it off
+a live instance, so `redis_id` must reference an existing instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_redis_instance_params" "params" {
+  redis_id = "crs-xxxxxxxx"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudRedisInstanceParams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudRedisInstanceParamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the redis instance to read supported parameters off of.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"params_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of supported parameters. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Parameter name, usable as a key in `tencentcloud_redis_instance`'s `parameters` map.",
+						},
+						"value_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Parameter value type, `enum`, `integer`, `text` or `multi`.",
+						},
+						"need_restart": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether changing this parameter requires restarting the instance.",
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Default value of the parameter.",
+						},
+						"current_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current value of the parameter on this instance.",
+						},
+						"tips": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the parameter.",
+						},
+						"enum_value": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Allowed values, set when `value_type` is `enum` or `multi`.",
+						},
+						"min": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Minimum allowed value, set when `value_type` is `integer`.",
+						},
+						"max": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Maximum allowed value, set when `value_type` is `integer`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudRedisInstanceParamsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_redis_instance_params.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisId := d.Get("redis_id").(string)
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		params, e := redisService.DescribeInstanceSupportedParams(ctx, redisId)
+		if e != nil {
+			return retryError(e)
+		}
+
+		paramsList := make([]map[string]interface{}, 0, len(params))
+		for _, p := range params {
+			paramsList = append(paramsList, map[string]interface{}{
+				"name":          p.ParamName,
+				"value_type":    p.ValueType,
+				"need_restart":  p.NeedRestart,
+				"default_value": p.DefaultValue,
+				"current_value": p.CurrentValue,
+				"tips":          p.Tips,
+				"enum_value":    p.EnumValue,
+				"min":           p.Min,
+				"max":           p.Max,
+			})
+		}
+
+		d.SetId(helper.DataResourceIdsHash([]string{redisId}))
+		if e := d.Set("params_list", paramsList); e != nil {
+			log.Printf("[CRITAL]%s provider set redis instance params list fail, reason:%s\n", logId, e.Error())
+			return resource.NonRetryableError(e)
+		}
+
+		output, ok := d.GetOk("result_output_file")
+		if ok && output.(string) != "" {
+			if e := writeToFile(output.(string), paramsList); e != nil {
+				return resource.NonRetryableError(e)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read redis instance params failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// +build tencentcloud
+
+/*
+Use this data source to query detailed information of TSE instances.
+
+Example Usage
+
+```hcl
+data "tencentcloud_tse_instances" "foo" {
+  instance_id   = "tse-xfqag"
+  instance_name = "terraform-test"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudTseInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudTseInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the TSE instance to filter results.",
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the TSE instance to filter results.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"instance_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the TSE instances.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the TSE instance.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the TSE instance.",
+						},
+						"engine_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Engine type of the TSE instance.",
+						},
+						"engine_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Engine version of the TSE instance.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the VPC.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the subnet.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the TSE instance.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Create time of the TSE instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudTseInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_tse_instances.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instanceId := d.Get("instance_id").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	instances, err := tseService.DescribeTseInstances(ctx, instanceId, instanceName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE instances failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	ids := make([]string, 0, len(instances))
+	list := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		list = append(list, map[string]interface{}{
+			"instance_id":    instance.InstanceId,
+			"name":           instance.Name,
+			"engine_type":    instance.EngineType,
+			"engine_version": instance.EngineVersion,
+			"vpc_id":         instance.VpcId,
+			"subnet_id":      instance.SubnetId,
+			"status":         instance.Status,
+			"create_time":    instance.CreateTime,
+		})
+		if instance.InstanceId != nil {
+			ids = append(ids, *instance.InstanceId)
+		}
+	}
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("instance_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set TSE instance list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// +build tencentcloud
+
+/*
+Use this data source to query the replica nodes of a TSE Nacos instance.
+
+Example Usage
+
+```hcl
+data "tencentcloud_tse_nacos_replicas" "foo" {
+  instance_id = tencentcloud_tse_instance.foo.id
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceTencentCloudTseNacosReplicas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudTseNacosReplicasRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the TSE Nacos instance.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"replica_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Replica node list of the Nacos instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the replica node.",
+						},
+						"intranet_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Intranet address of the replica node.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the replica node.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudTseNacosReplicasRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_tse_nacos_replicas.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instanceId := d.Get("instance_id").(string)
+
+	replicas, err := tseService.DescribeTseNacosReplicas(ctx, instanceId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE Nacos replicas failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	list := make([]map[string]interface{}, 0, len(replicas))
+	for _, replica := range replicas {
+		list = append(list, map[string]interface{}{
+			"name":             replica.Name,
+			"intranet_address": replica.IntranetAddress,
+			"status":           replica.Status,
+		})
+	}
+	d.SetId(instanceId)
+	if err := d.Set("replica_list", list); err != nil {
+		log.Printf("[CRITAL]%s provider set TSE Nacos replica list fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := writeToFile(output.(string), list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// +build tencentcloud
+
+/*
+Use this data source to query static routes of a VPN connection.
+
+Example Usage
+
+```hcl
+data "tencentcloud_vpn_connection_routes" "routes" {
+  vpn_connection_id = tencentcloud_vpn_connection.my_vpn_conn.id
+}
+```
+*/
+package tencentcloud
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+)
+
+func dataSourceTencentCloudVpnConnectionRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudVpnConnectionRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the VPN connection to list routes for.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"route_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the VPN connection routes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Destination CIDR block advertised by this static route.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the route.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudVpnConnectionRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_vpn_connection_routes.read")()
+
+	logId := getLogId(contextNil)
+
+	connectionId := d.Get("vpn_connection_id").(string)
+	request := vpc.NewDescribeVpnConnectionRoutesRequest()
+	request.VpnConnectionId = &connectionId
+
+	var response *vpc.DescribeVpnConnectionRoutesResponse
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionRoutes(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN connection routes failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	routeList := make([]map[string]interface{}, 0, len(response.Response.RouteSet))
+	for _, route := range response.Response.RouteSet {
+		routeList = append(routeList, map[string]interface{}{
+			"destination_cidr_block": *route.DestinationCidrBlock,
+			"state":                  *route.Status,
+		})
+	}
+	_ = d.Set("route_list", routeList)
+
+	d.SetId(connectionId)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), routeList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
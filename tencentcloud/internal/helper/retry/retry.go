@@ -0,0 +1,81 @@
+// Package retry implements full-jitter exponential backoff retries for
+// Tencent Cloud SDK calls, replacing the hand-rolled
+// time.Sleep(1s) -> 3s -> 5s ladders that used to be duplicated at each
+// retry site (e.g. RedisService.CheckRedisCreateOk/DescribeInstanceDealDetail).
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Policy configures RetryWithBackoff's delay schedule.
+type Policy struct {
+	// MaxAttempts is the maximum number of times fn is called, including
+	// the first (non-retry) call. A Policy with MaxAttempts <= 0 is
+	// treated as 1 (no retries).
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry; each subsequent
+	// retry's delay doubles (base * 2^attempt) before the full-jitter
+	// randomization below, up to MaxDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the pre-jitter delay.
+	MaxDelay time.Duration
+}
+
+// DefaultPolicy matches the ladder this package replaces: up to 4 attempts
+// (the original call plus 3 retries), starting at a 1s delay and capping at
+// 5s, which is what the 1s -> 3s -> 5s sequence in CheckRedisCreateOk and
+// DescribeInstanceDealDetail amounted to.
+var DefaultPolicy = Policy{MaxAttempts: 4, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+// Classifier decides whether an error returned by fn should be retried.
+// Returning false stops retrying and surfaces err immediately.
+type Classifier func(err error) bool
+
+// RetryWithBackoff calls fn until it succeeds, ctx is done, policy.MaxAttempts
+// is exhausted, or classify reports an error as terminal. Delays between
+// attempts use full-jitter exponential backoff: pre-jitter delay is
+// min(policy.BaseDelay * 2^(attempt-1), policy.MaxDelay), and the actual
+// sleep is a uniformly random duration in [0, pre-jitter delay).
+func RetryWithBackoff(ctx context.Context, policy Policy, classify Classifier, fn func() error) error {
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		err = fn()
+		if err == nil {
+			return nil
+		}
+		if classify != nil && !classify(err) {
+			return err
+		}
+		if attempt == maxAttempts {
+			return err
+		}
+
+		delay := policy.BaseDelay << uint(attempt-1)
+		if delay <= 0 || delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+		if delay > 0 {
+			jittered := time.Duration(rand.Int63n(int64(delay)))
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(jittered):
+			}
+		}
+	}
+	return err
+}
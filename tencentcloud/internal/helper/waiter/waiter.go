@@ -0,0 +1,53 @@
+// Package waiter provides a small generic abstraction, modeled on the
+// terraform-provider-google ComputeOperationWaiter, for polling a Tencent
+// Cloud long-running operation until it reaches a terminal state.
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// OperationWaiter describes a long-running Tencent Cloud operation that can
+// be polled to completion with WaitForState.
+type OperationWaiter interface {
+	// RefreshFunc returns the StateRefreshFunc used to poll the
+	// operation's current state.
+	RefreshFunc() resource.StateRefreshFunc
+
+	// PendingStates lists the states that mean the operation is still in
+	// progress and polling should continue.
+	PendingStates() []string
+
+	// TargetStates lists the states that mean the operation has finished
+	// successfully.
+	TargetStates() []string
+
+	// Timeout is the maximum time to wait for a target state.
+	Timeout() time.Duration
+}
+
+// WaitForState polls w until it reaches one of its target states, ctx is
+// cancelled, or its timeout elapses. The final refreshed object is
+// returned on success.
+func WaitForState(ctx context.Context, w OperationWaiter) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	conf := &resource.StateChangeConf{
+		Pending: w.PendingStates(),
+		Target:  w.TargetStates(),
+		Refresh: w.RefreshFunc(),
+		Timeout: w.Timeout(),
+	}
+
+	result, err := conf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	return result, ctx.Err()
+}
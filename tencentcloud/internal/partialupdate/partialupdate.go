@@ -0,0 +1,68 @@
+// Package partialupdate helps long-lived resources apply field-level
+// changes through dedicated per-attribute handlers instead of one
+// monolithic Update function, so that an unrelated field change never
+// has to fall back to a full recreate.
+package partialupdate
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Handler applies the change for a single schema key. oldVal/newVal are
+// the raw values as returned by d.GetChange(key).
+type Handler func(ctx context.Context, d *schema.ResourceData, meta interface{}, oldVal, newVal interface{}) error
+
+// FieldUpdate registers a Handler for one schema key.
+type FieldUpdate struct {
+	Key string
+
+	// RequiresRestart documents that, although this field is handled
+	// without a ForceNew in the schema, applying it causes the
+	// underlying resource to restart/reboot. It is informational only:
+	// Plan.Run does not reorder or gate on it, callers that need to
+	// warn users should inspect it before calling Run.
+	RequiresRestart bool
+
+	Apply Handler
+}
+
+// Plan is an ordered set of per-attribute update handlers for one resource.
+type Plan struct {
+	logId   string
+	updates []FieldUpdate
+}
+
+// New builds a Plan from the given field updates. Updates are applied in
+// the order given when any of them have changed.
+func New(logId string, updates ...FieldUpdate) *Plan {
+	return &Plan{logId: logId, updates: updates}
+}
+
+// Run walks the registered updates in order, invoking the Apply handler for
+// every key that has changed on d, and logs which handler fired for which
+// key. It stops and returns the first error encountered.
+func (p *Plan) Run(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	d.Partial(true)
+	defer d.Partial(false)
+
+	for _, update := range p.updates {
+		if !d.HasChange(update.Key) {
+			continue
+		}
+
+		oldVal, newVal := d.GetChange(update.Key)
+		log.Printf("[DEBUG]%s partialupdate: applying change for %s (requires_restart=%t)\n", p.logId, update.Key, update.RequiresRestart)
+
+		if err := update.Apply(ctx, d, meta, oldVal, newVal); err != nil {
+			return fmt.Errorf("partialupdate: failed to apply change for %s: %s", update.Key, err.Error())
+		}
+
+		d.SetPartial(update.Key)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Package policy implements a KICS-style preflight linter for the resources
+// managed by this provider. It is consumed by the `validate` subcommand of
+// the provider binary, which runs the rules in this package against a
+// Terraform plan before `terraform apply` is allowed to proceed.
+package policy
+
+import "fmt"
+
+// Severity classifies how serious a policy violation is.
+type Severity string
+
+const (
+	SeverityLow      Severity = "LOW"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityHigh     Severity = "HIGH"
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// EnforcementMode controls whether a violation only warns or blocks the plan.
+type EnforcementMode string
+
+const (
+	EnforcementWarn  EnforcementMode = "warn"
+	EnforcementBlock EnforcementMode = "block"
+)
+
+// Resource is the subset of a planned Terraform resource a rule needs to
+// inspect. Values holds the resource's planned attribute values, keyed by
+// attribute name exactly as they appear in the resource schema.
+type Resource struct {
+	Address string
+	Type    string
+	Values  map[string]interface{}
+}
+
+// Violation is a single rule failure found against one planned resource.
+type Violation struct {
+	RuleID         string
+	Severity       Severity
+	Title          string
+	RemediationURL string
+	ResourceAddr   string
+	Message        string
+	Blocking       bool
+}
+
+// Rule is a single built-in policy check. Check is run once per resource in
+// the plan whose type matches ResourceTypes; it returns a non-empty message
+// when the resource violates the rule.
+type Rule struct {
+	ID             string
+	Title          string
+	Severity       Severity
+	RemediationURL string
+	// ResourceTypes lists the resource types this rule applies to. When a
+	// rule needs to reason across resource types (e.g. "is there a companion
+	// resource of type X"), it is given the full plan via Check.
+	ResourceTypes []string
+	Check         func(target Resource, plan []Resource) (violated bool, message string)
+}
+
+// Evaluate runs every built-in rule against every resource in the plan and
+// returns the violations found. mode determines whether each violation is
+// marked as blocking.
+func Evaluate(plan []Resource, mode EnforcementMode) []Violation {
+	var violations []Violation
+	for _, rule := range Rules {
+		for _, res := range plan {
+			if !appliesTo(rule, res.Type) {
+				continue
+			}
+			if violated, message := rule.Check(res, plan); violated {
+				violations = append(violations, Violation{
+					RuleID:         rule.ID,
+					Severity:       rule.Severity,
+					Title:          rule.Title,
+					RemediationURL: rule.RemediationURL,
+					ResourceAddr:   res.Address,
+					Message:        message,
+					Blocking:       mode == EnforcementBlock,
+				})
+			}
+		}
+	}
+	return violations
+}
+
+func appliesTo(rule Rule, resourceType string) bool {
+	for _, t := range rule.ResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders a violation as a single human-readable line, suitable for
+// printing to stderr from the `validate` subcommand.
+func (v Violation) String() string {
+	return fmt.Sprintf("[%s][%s] %s: %s (%s)", v.Severity, v.RuleID, v.ResourceAddr, v.Message, v.RemediationURL)
+}
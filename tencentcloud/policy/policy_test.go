@@ -0,0 +1,84 @@
+package policy
+
+import "testing"
+
+func TestEvaluatePublicMySQL(t *testing.T) {
+	plan := []Resource{
+		{
+			Address: "tencentcloud_mysql_instance.foo",
+			Type:    "tencentcloud_mysql_instance",
+			Values:  map[string]interface{}{"internet_service": float64(1)},
+		},
+	}
+
+	violations := Evaluate(plan, EnforcementWarn)
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if violations[0].RuleID != "TC001" {
+		t.Errorf("expected rule TC001, got %s", violations[0].RuleID)
+	}
+	if violations[0].Blocking {
+		t.Errorf("expected non-blocking violation under warn mode")
+	}
+}
+
+func TestEvaluateOpenIngressIsBlockingInBlockMode(t *testing.T) {
+	plan := []Resource{
+		{
+			Address: "tencentcloud_security_group_rule.foo",
+			Type:    "tencentcloud_security_group_rule",
+			Values: map[string]interface{}{
+				"type":    "ingress",
+				"cidr_ip": "0.0.0.0/0",
+				"policy":  "ACCEPT",
+			},
+		},
+	}
+
+	violations := Evaluate(plan, EnforcementBlock)
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if !violations[0].Blocking {
+		t.Errorf("expected blocking violation under block mode")
+	}
+}
+
+func TestEvaluateCbsStorageWithAttachedSnapshotPolicy(t *testing.T) {
+	plan := []Resource{
+		{
+			Address: "tencentcloud_cbs_storage.foo",
+			Type:    "tencentcloud_cbs_storage",
+			Values:  map[string]interface{}{"id": "disk-abc123"},
+		},
+		{
+			Address: "tencentcloud_cbs_snapshot_policy_attachment.foo",
+			Type:    "tencentcloud_cbs_snapshot_policy_attachment",
+			Values:  map[string]interface{}{"storage_id": "disk-abc123"},
+		},
+	}
+
+	violations := Evaluate(plan, EnforcementWarn)
+	if len(violations) != 0 {
+		t.Fatalf("expected no violations, got %d: %v", len(violations), violations)
+	}
+}
+
+func TestEvaluateCbsStorageWithoutAttachedSnapshotPolicy(t *testing.T) {
+	plan := []Resource{
+		{
+			Address: "tencentcloud_cbs_storage.foo",
+			Type:    "tencentcloud_cbs_storage",
+			Values:  map[string]interface{}{"id": "disk-abc123"},
+		},
+	}
+
+	violations := Evaluate(plan, EnforcementWarn)
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if violations[0].RuleID != "TC007" {
+		t.Errorf("expected rule TC007, got %s", violations[0].RuleID)
+	}
+}
@@ -0,0 +1,141 @@
+package policy
+
+import "fmt"
+
+// Rules is the set of built-in policy checks bundled with the provider.
+var Rules = []Rule{
+	{
+		ID:             "TC001",
+		Title:          "MySQL instance exposed to the public internet",
+		Severity:       SeverityHigh,
+		RemediationURL: "https://cloud.tencent.com/document/product/236/18724",
+		ResourceTypes:  []string{"tencentcloud_mysql_instance"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			if intAttr(target.Values, "internet_service") == 1 {
+				return true, "internet_service is enabled, exposing the instance to the public internet"
+			}
+			return false, ""
+		},
+	},
+	{
+		ID:             "TC002",
+		Title:          "Kubernetes cluster without encryption protection enabled",
+		Severity:       SeverityMedium,
+		RemediationURL: "https://cloud.tencent.com/document/product/457/54621",
+		ResourceTypes:  []string{"tencentcloud_kubernetes_cluster"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			for _, res := range plan {
+				if res.Type != "tencentcloud_kubernetes_encryption_protection" {
+					continue
+				}
+				if stringAttr(res.Values, "cluster_id") == clusterIdOf(target) {
+					return false, ""
+				}
+			}
+			return true, "no tencentcloud_kubernetes_encryption_protection resource references this cluster"
+		},
+	},
+	{
+		ID:             "TC003",
+		Title:          "TKE cluster has a public endpoint without a security group",
+		Severity:       SeverityHigh,
+		RemediationURL: "https://cloud.tencent.com/document/product/457/54622",
+		ResourceTypes:  []string{"tencentcloud_kubernetes_cluster"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			if boolAttr(target.Values, "cluster_internet") && stringAttr(target.Values, "cluster_security_group_id") == "" {
+				return true, "cluster_internet is true but cluster_security_group_id is not set"
+			}
+			return false, ""
+		},
+	},
+	{
+		ID:             "TC004",
+		Title:          "TKE cluster has audit logging disabled",
+		Severity:       SeverityMedium,
+		RemediationURL: "https://cloud.tencent.com/document/product/457/54623",
+		ResourceTypes:  []string{"tencentcloud_kubernetes_cluster"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			if !boolAttr(target.Values, "cluster_audit") {
+				return true, "cluster_audit is disabled"
+			}
+			if !boolAttr(target.Values, "log_agent") {
+				return true, "log_agent is disabled"
+			}
+			return false, ""
+		},
+	},
+	{
+		ID:             "TC005",
+		Title:          "COS bucket without server-side encryption",
+		Severity:       SeverityMedium,
+		RemediationURL: "https://cloud.tencent.com/document/product/436/17871",
+		ResourceTypes:  []string{"tencentcloud_cos_bucket"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			if !boolAttr(target.Values, "encryption") {
+				return true, "server-side encryption is not enabled"
+			}
+			return false, ""
+		},
+	},
+	{
+		ID:             "TC006",
+		Title:          "Security group rule allows ingress from 0.0.0.0/0",
+		Severity:       SeverityCritical,
+		RemediationURL: "https://cloud.tencent.com/document/product/215/20089",
+		ResourceTypes:  []string{"tencentcloud_security_group_rule"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			if stringAttr(target.Values, "type") == "ingress" &&
+				stringAttr(target.Values, "cidr_ip") == "0.0.0.0/0" &&
+				stringAttr(target.Values, "policy") == "ACCEPT" {
+				return true, "ingress rule accepts traffic from 0.0.0.0/0"
+			}
+			return false, ""
+		},
+	},
+	{
+		ID:             "TC007",
+		Title:          "CBS storage without an attached snapshot policy",
+		Severity:       SeverityLow,
+		RemediationURL: "https://cloud.tencent.com/document/product/362/18216",
+		ResourceTypes:  []string{"tencentcloud_cbs_storage"},
+		Check: func(target Resource, plan []Resource) (bool, string) {
+			for _, res := range plan {
+				if res.Type != "tencentcloud_cbs_snapshot_policy_attachment" {
+					continue
+				}
+				if stringAttr(res.Values, "storage_id") == stringAttr(target.Values, "id") {
+					return false, ""
+				}
+			}
+			return true, "no tencentcloud_cbs_snapshot_policy_attachment resource references this storage"
+		},
+	},
+}
+
+func clusterIdOf(target Resource) string {
+	if id := stringAttr(target.Values, "id"); id != "" {
+		return id
+	}
+	return fmt.Sprintf("%v", target.Values["cluster_id"])
+}
+
+func intAttr(values map[string]interface{}, key string) int {
+	switch v := values[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
+
+func boolAttr(values map[string]interface{}, key string) bool {
+	v, _ := values[key].(bool)
+	return v
+}
+
+func stringAttr(values map[string]interface{}, key string) string {
+	v, _ := values[key].(string)
+	return v
+}
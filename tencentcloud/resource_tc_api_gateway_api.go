@@ -0,0 +1,503 @@
+// +build tencentcloud
+
+/*
+Use this resource to create API of API gateway service.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_api" "api" {
+  service_id               = tencentcloud_api_gateway_service.service.id
+  api_name                 = "tf_example"
+  api_desc                 = "my hello api"
+  api_type                 = "NORMAL"
+  auth_type                = "NONE"
+  protocol                 = "HTTP"
+  enable_cors              = true
+  request_config_path      = "/user/info"
+  request_config_method    = "GET"
+  service_type             = "HTTP"
+  service_config_url       = "http://backend.example.com"
+  service_config_path      = "/user/info"
+  service_config_method    = "GET"
+  response_type            = "JSON"
+  response_success_example = "{\"code\":0}"
+  response_fail_example    = "{\"code\":1}"
+
+  request_parameters {
+    name          = "name"
+    position      = "QUERY"
+    type          = "string"
+    desc          = "name of the user"
+    default_value = ""
+    required      = true
+  }
+}
+```
+
+Import
+
+API of API gateway service can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_api.api service-pg6ud8pa#api-p8gtanm9
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayAPI() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayAPICreate,
+		Read:   resourceTencentCloudAPIGatewayAPIRead,
+		Update: resourceTencentCloudAPIGatewayAPIUpdate,
+		Delete: resourceTencentCloudAPIGatewayAPIDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway service that this API belongs to.",
+			},
+			"api_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom API name.",
+			},
+			"api_desc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom API description.",
+			},
+			"api_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NORMAL",
+				ValidateFunc: validateAllowedStringValue([]string{"NORMAL", "TSF"}),
+				Description:  "API type, supports `NORMAL` and `TSF`, the default is `NORMAL`.",
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validateAllowedStringValue([]string{"SECRET", "NONE", "OAUTH"}),
+				Description:  "API authentication type, supports `SECRET`, `NONE` and `OAUTH`, the default is `NONE`.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HTTP",
+				ValidateFunc: validateAllowedStringValue([]string{"HTTP", "HTTPS", "HTTP&HTTPS"}),
+				Description:  "API frontend request type, supports `HTTP`, `HTTPS` and `HTTP&HTTPS`.",
+			},
+			"enable_cors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to enable CORS.",
+			},
+			"request_config_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the API frontend request, e.g. `/user/info`.",
+			},
+			"request_config_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"GET", "POST", "PUT", "DELETE", "HEAD", "ANY"}),
+				Description:  "Method of the API frontend request.",
+			},
+			"service_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HTTP",
+				ValidateFunc: validateAllowedStringValue([]string{"HTTP", "MOCK", "SCF", "TSF"}),
+				Description:  "API backend service type, supports `HTTP`, `MOCK`, `SCF` and `TSF`.",
+			},
+			"service_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     15,
+				Description: "API backend service timeout in seconds, default is 15.",
+			},
+			"service_config_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Backend service url, required when `service_type` is `HTTP`.",
+			},
+			"service_config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Backend service path, required when `service_type` is `HTTP`.",
+			},
+			"service_config_method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Backend service method, required when `service_type` is `HTTP`.",
+			},
+			"response_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "JSON",
+				ValidateFunc: validateAllowedStringValue([]string{"HTML", "JSON", "TEXT", "BINARY", "XML"}),
+				Description:  "Return type of the API.",
+			},
+			"response_success_example": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Successful response sample of custom response configuration.",
+			},
+			"response_fail_example": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Failed response sample of custom response configuration.",
+			},
+			"request_parameters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Frontend request parameter list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Parameter name.",
+						},
+						"position": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"HEADER", "QUERY", "PATH"}),
+							Description:  "Parameter position, supports `HEADER`, `QUERY` and `PATH`.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Parameter type, e.g. `string`, `int`.",
+						},
+						"desc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Parameter description.",
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Default value of the parameter.",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicate whether this parameter is required.",
+						},
+					},
+				},
+			},
+			"service_parameters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Backend service parameter list mapped from `request_parameters`, required when `service_type` is `HTTP`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Backend service parameter name.",
+						},
+						"position": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Backend service parameter position.",
+						},
+						"relevant_request_parameter_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the corresponding frontend request parameter.",
+						},
+						"relevant_request_parameter_position": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Position of the corresponding frontend request parameter.",
+						},
+					},
+				},
+			},
+			"constant_parameters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Constant parameter list passed to the backend service regardless of the frontend request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Constant parameter name.",
+						},
+						"position": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Constant parameter position.",
+						},
+						"desc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Constant parameter description.",
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of the constant parameter sent to the backend.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func apiGatewayApiId(serviceId, apiId string) string {
+	return serviceId + FILED_SP + apiId
+}
+
+func parseApiGatewayApiId(id string) (serviceId, apiId string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid API gateway api id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func apiGatewayApiRequestParameters(d *schema.ResourceData) []*apigateway.ReqParameter {
+	v, ok := d.GetOk("request_parameters")
+	if !ok {
+		return nil
+	}
+	params := make([]*apigateway.ReqParameter, 0)
+	for _, item := range v.([]interface{}) {
+		p := item.(map[string]interface{})
+		params = append(params, &apigateway.ReqParameter{
+			Name:         helper.String(p["name"].(string)),
+			Position:     helper.String(p["position"].(string)),
+			Type:         helper.String(p["type"].(string)),
+			Desc:         helper.String(p["desc"].(string)),
+			DefaultValue: helper.String(p["default_value"].(string)),
+			Required:     helper.Bool(p["required"].(bool)),
+		})
+	}
+	return params
+}
+
+func apiGatewayApiServiceParameters(d *schema.ResourceData) []*apigateway.ServiceParameter {
+	v, ok := d.GetOk("service_parameters")
+	if !ok {
+		return nil
+	}
+	params := make([]*apigateway.ServiceParameter, 0)
+	for _, item := range v.([]interface{}) {
+		p := item.(map[string]interface{})
+		params = append(params, &apigateway.ServiceParameter{
+			Name:                             helper.String(p["name"].(string)),
+			Position:                         helper.String(p["position"].(string)),
+			RelevantRequestParameterName:     helper.String(p["relevant_request_parameter_name"].(string)),
+			RelevantRequestParameterPosition: helper.String(p["relevant_request_parameter_position"].(string)),
+		})
+	}
+	return params
+}
+
+func apiGatewayApiConstantParameters(d *schema.ResourceData) []*apigateway.ConstantParameter {
+	v, ok := d.GetOk("constant_parameters")
+	if !ok {
+		return nil
+	}
+	params := make([]*apigateway.ConstantParameter, 0)
+	for _, item := range v.([]interface{}) {
+		p := item.(map[string]interface{})
+		params = append(params, &apigateway.ConstantParameter{
+			Name:         helper.String(p["name"].(string)),
+			Position:     helper.String(p["position"].(string)),
+			Desc:         helper.String(p["desc"].(string)),
+			DefaultValue: helper.String(p["default_value"].(string)),
+		})
+	}
+	return params
+}
+
+func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+
+	request := apigateway.NewCreateApiRequest()
+	request.ServiceId = &serviceId
+	request.ApiName = helper.String(d.Get("api_name").(string))
+	request.ApiDesc = helper.String(d.Get("api_desc").(string))
+	request.ApiType = helper.String(d.Get("api_type").(string))
+	request.AuthType = helper.String(d.Get("auth_type").(string))
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.EnableCORS = helper.Bool(d.Get("enable_cors").(bool))
+	request.RequestConfig = &apigateway.RequestConfig{
+		Path:   helper.String(d.Get("request_config_path").(string)),
+		Method: helper.String(d.Get("request_config_method").(string)),
+	}
+	request.ServiceType = helper.String(d.Get("service_type").(string))
+	request.ServiceTimeout = helper.IntInt64(d.Get("service_timeout").(int))
+	request.ServiceConfig = &apigateway.ServiceConfig{
+		Url:    helper.String(d.Get("service_config_url").(string)),
+		Path:   helper.String(d.Get("service_config_path").(string)),
+		Method: helper.String(d.Get("service_config_method").(string)),
+	}
+	request.ResponseType = helper.String(d.Get("response_type").(string))
+	request.ResponseSuccessExample = helper.String(d.Get("response_success_example").(string))
+	request.ResponseFailExample = helper.String(d.Get("response_fail_example").(string))
+	request.RequestParameters = apiGatewayApiRequestParameters(d)
+	request.ServiceParameters = apiGatewayApiServiceParameters(d)
+	request.ConstantParameters = apiGatewayApiConstantParameters(d)
+
+	apiId, err := apiGatewayService.CreateApi(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create API gateway api failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayApiId(serviceId, apiId))
+
+	return resourceTencentCloudAPIGatewayAPIRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayAPIRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, apiId, err := parseApiGatewayApiId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	api, err := apiGatewayService.DescribeApiById(ctx, serviceId, apiId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway api failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the api has been deleted out-of-band, recreate it on next apply
+	if api == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_id", serviceId)
+	_ = d.Set("api_name", api.ApiName)
+	_ = d.Set("api_desc", api.ApiDesc)
+	_ = d.Set("api_type", api.ApiType)
+	_ = d.Set("auth_type", api.AuthType)
+	_ = d.Set("protocol", api.Protocol)
+	_ = d.Set("enable_cors", api.EnableCORS)
+	_ = d.Set("service_type", api.ServiceType)
+	_ = d.Set("response_type", api.ResponseType)
+	_ = d.Set("response_success_example", api.ResponseSuccessExample)
+	_ = d.Set("response_fail_example", api.ResponseFailExample)
+	if api.RequestConfig != nil {
+		_ = d.Set("request_config_path", api.RequestConfig.Path)
+		_ = d.Set("request_config_method", api.RequestConfig.Method)
+	}
+	if api.ServiceConfig != nil {
+		_ = d.Set("service_config_url", api.ServiceConfig.Url)
+		_ = d.Set("service_config_path", api.ServiceConfig.Path)
+		_ = d.Set("service_config_method", api.ServiceConfig.Method)
+	}
+	_ = d.Set("service_timeout", api.ServiceTimeout)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, apiId, err := parseApiGatewayApiId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewModifyApiRequest()
+	request.ServiceId = &serviceId
+	request.ApiId = &apiId
+	request.ApiName = helper.String(d.Get("api_name").(string))
+	request.ApiDesc = helper.String(d.Get("api_desc").(string))
+	request.AuthType = helper.String(d.Get("auth_type").(string))
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.EnableCORS = helper.Bool(d.Get("enable_cors").(bool))
+	request.RequestConfig = &apigateway.RequestConfig{
+		Path:   helper.String(d.Get("request_config_path").(string)),
+		Method: helper.String(d.Get("request_config_method").(string)),
+	}
+	request.ServiceType = helper.String(d.Get("service_type").(string))
+	request.ServiceTimeout = helper.IntInt64(d.Get("service_timeout").(int))
+	request.ServiceConfig = &apigateway.ServiceConfig{
+		Url:    helper.String(d.Get("service_config_url").(string)),
+		Path:   helper.String(d.Get("service_config_path").(string)),
+		Method: helper.String(d.Get("service_config_method").(string)),
+	}
+	request.ResponseType = helper.String(d.Get("response_type").(string))
+	request.ResponseSuccessExample = helper.String(d.Get("response_success_example").(string))
+	request.ResponseFailExample = helper.String(d.Get("response_fail_example").(string))
+	request.RequestParameters = apiGatewayApiRequestParameters(d)
+	request.ServiceParameters = apiGatewayApiServiceParameters(d)
+	request.ConstantParameters = apiGatewayApiConstantParameters(d)
+
+	if err := apiGatewayService.ModifyApi(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s update API gateway api failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudAPIGatewayAPIRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayAPIDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, apiId, err := parseApiGatewayApiId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.DeleteApi(ctx, serviceId, apiId); err != nil {
+		log.Printf("[CRITAL]%s delete API gateway api failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// +build tencentcloud
+
+/*
+Use this resource to create an API gateway access key pair, used to authenticate
+requests to a `tencentcloud_api_gateway_api` resource with `auth_type` set to `SECRET`.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_api_key" "key" {
+  secret_name = "tf_example_key"
+  status      = "on"
+}
+```
+
+Import
+
+API gateway access key can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_api_key.key AKID************************
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayAPIKeyCreate,
+		Read:   resourceTencentCloudAPIGatewayAPIKeyRead,
+		Update: resourceTencentCloudAPIGatewayAPIKeyUpdate,
+		Delete: resourceTencentCloudAPIGatewayAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom key name.",
+			},
+			"access_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "auto",
+				ValidateFunc: validateAllowedStringValue([]string{"auto", "manual"}),
+				Description:  "Key type, `auto` generates a random access key/secret pair, `manual` requires `access_key_id`/`access_key_secret` to be set. Default is `auto`.",
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Custom access key id, required when `access_key_type` is `manual`.",
+			},
+			"access_key_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Custom access key secret, required when `access_key_type` is `manual`.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "on",
+				ValidateFunc: validateAllowedStringValue([]string{"on", "off"}),
+				Description:  "Key status, `on` or `off`. Default is `on`.",
+			},
+
+			// Computed values
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the key.",
+			},
+			"modify_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modify time of the key.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewCreateApiKeyRequest()
+	request.SecretName = helper.String(d.Get("secret_name").(string))
+	request.AccessKeyType = helper.String(d.Get("access_key_type").(string))
+	if v, ok := d.GetOk("access_key_id"); ok {
+		request.AccessKeyId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("access_key_secret"); ok {
+		request.AccessKeySecret = helper.String(v.(string))
+	}
+
+	accessKeyId, err := apiGatewayService.CreateApiKey(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create API gateway key failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(accessKeyId)
+
+	if d.Get("status").(string) == "off" {
+		if err := apiGatewayService.ModifyApiKeyStatus(ctx, accessKeyId, false); err != nil {
+			log.Printf("[CRITAL]%s disable API gateway key failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	return resourceTencentCloudAPIGatewayAPIKeyRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	accessKeyId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	apiKey, err := apiGatewayService.DescribeApiKeyById(ctx, accessKeyId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway key failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the key has been deleted out-of-band, recreate it on next apply
+	if apiKey == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("secret_name", apiKey.SecretName)
+	_ = d.Set("status", apiKey.Status)
+	_ = d.Set("create_time", apiKey.CreatedTime)
+	_ = d.Set("modify_time", apiKey.ModifiedTime)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	accessKeyId := d.Id()
+
+	if d.HasChange("status") {
+		apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+		enable := d.Get("status").(string) == "on"
+		if err := apiGatewayService.ModifyApiKeyStatus(ctx, accessKeyId, enable); err != nil {
+			log.Printf("[CRITAL]%s update API gateway key status failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	return resourceTencentCloudAPIGatewayAPIKeyRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	accessKeyId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.DeleteApiKey(ctx, accessKeyId); err != nil {
+		log.Printf("[CRITAL]%s delete API gateway key failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
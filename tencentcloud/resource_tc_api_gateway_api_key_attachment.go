@@ -0,0 +1,150 @@
+// +build tencentcloud
+
+/*
+Use this resource to attach an API gateway access key to a usage plan.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_api_key_attachment" "attachment" {
+  api_key_id    = tencentcloud_api_gateway_api_key.key.id
+  usage_plan_id = tencentcloud_api_gateway_usage_plan.plan.id
+}
+```
+
+Import
+
+API gateway access key attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_api_key_attachment.attachment usagePlan-gyeafpab#AKID************************
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTencentCloudAPIGatewayAPIKeyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate,
+		Read:   resourceTencentCloudAPIGatewayAPIKeyAttachmentRead,
+		Delete: resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway access key to attach.",
+			},
+			"usage_plan_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the usage plan to attach the key to.",
+			},
+		},
+	}
+}
+
+func apiGatewayApiKeyAttachmentId(usagePlanId, accessKeyId string) string {
+	return usagePlanId + FILED_SP + accessKeyId
+}
+
+func parseApiGatewayApiKeyAttachmentId(id string) (usagePlanId, accessKeyId string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid API gateway key attachment id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key_attachment.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	accessKeyId := d.Get("api_key_id").(string)
+	usagePlanId := d.Get("usage_plan_id").(string)
+
+	if err := apiGatewayService.BindSecretIds(ctx, usagePlanId, []*string{&accessKeyId}); err != nil {
+		log.Printf("[CRITAL]%s attach API gateway key failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayApiKeyAttachmentId(usagePlanId, accessKeyId))
+
+	return resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key_attachment.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId, accessKeyId, err := parseApiGatewayApiKeyAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	accessKeyIds, err := apiGatewayService.DescribeApiKeysAttachedByUsagePlan(ctx, usagePlanId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway key attachment failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	found := false
+	for _, id := range accessKeyIds {
+		if id == accessKeyId {
+			found = true
+			break
+		}
+	}
+	//the attachment has been removed out-of-band, recreate it on next apply
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("api_key_id", accessKeyId)
+	_ = d.Set("usage_plan_id", usagePlanId)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_api_key_attachment.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId, accessKeyId, err := parseApiGatewayApiKeyAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.UnBindSecretIds(ctx, usagePlanId, []*string{&accessKeyId}); err != nil {
+		log.Printf("[CRITAL]%s detach API gateway key failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
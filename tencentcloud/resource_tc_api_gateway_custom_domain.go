@@ -0,0 +1,256 @@
+// +build tencentcloud
+
+/*
+Use this resource to bind a custom domain name to an API gateway service.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_custom_domain" "domain" {
+  service_id         = tencentcloud_api_gateway_service.service.id
+  sub_domain         = "example.com"
+  protocol           = "http"
+  net_type           = "OUTER"
+  is_default_mapping = true
+}
+```
+
+Import
+
+API gateway custom domain can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_custom_domain.domain service-pg6ud8pa#example.com
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayCustomDomainCreate,
+		Read:   resourceTencentCloudAPIGatewayCustomDomainRead,
+		Update: resourceTencentCloudAPIGatewayCustomDomainUpdate,
+		Delete: resourceTencentCloudAPIGatewayCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway service that this custom domain is bound to.",
+			},
+			"sub_domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom domain name.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "http",
+				ValidateFunc: validateAllowedStringValue([]string{"http", "https", "http&https"}),
+				Description:  "Network protocol supported by the domain, default is `http`.",
+			},
+			"net_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "OUTER",
+				ValidateFunc: validateAllowedStringValue([]string{"INNER", "OUTER"}),
+				Description:  "Network type, default is `OUTER`.",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SSL certificate ID, required when `protocol` includes `https`.",
+			},
+			"is_default_mapping": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicate whether to use the default path mapping, default is `true`.",
+			},
+			"path_mappings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Custom path mapping, only effective when `is_default_mapping` is `false`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path prefix.",
+						},
+						"environment": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Environment that this path maps to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func apiGatewayCustomDomainId(serviceId, subDomain string) string {
+	return serviceId + FILED_SP + subDomain
+}
+
+func parseApiGatewayCustomDomainId(id string) (serviceId, subDomain string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid API gateway custom domain id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func apiGatewayPathMappings(d *schema.ResourceData) []*apigateway.PathMapping {
+	v, ok := d.GetOk("path_mappings")
+	if !ok {
+		return nil
+	}
+	mappings := make([]*apigateway.PathMapping, 0)
+	for _, item := range v.([]interface{}) {
+		m := item.(map[string]interface{})
+		mappings = append(mappings, &apigateway.PathMapping{
+			Path:        helper.String(m["path"].(string)),
+			Environment: helper.String(m["environment"].(string)),
+		})
+	}
+	return mappings
+}
+
+func resourceTencentCloudAPIGatewayCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+	subDomain := d.Get("sub_domain").(string)
+
+	request := apigateway.NewCreateCustomDomainRequest()
+	request.ServiceId = &serviceId
+	request.SubDomain = &subDomain
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.NetType = helper.String(d.Get("net_type").(string))
+	request.IsDefaultMapping = helper.Bool(d.Get("is_default_mapping").(bool))
+	if v, ok := d.GetOk("certificate_id"); ok {
+		request.CertificateId = helper.String(v.(string))
+	}
+	request.PathMappingSet = apiGatewayPathMappings(d)
+
+	if err := apiGatewayService.CreateCustomDomain(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s create API gateway custom domain failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayCustomDomainId(serviceId, subDomain))
+
+	return resourceTencentCloudAPIGatewayCustomDomainRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, subDomain, err := parseApiGatewayCustomDomainId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	domain, err := apiGatewayService.DescribeCustomDomainById(ctx, serviceId, subDomain)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway custom domain failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the custom domain has been unbound out-of-band, recreate it on next apply
+	if domain == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_id", serviceId)
+	_ = d.Set("sub_domain", subDomain)
+	_ = d.Set("protocol", domain.Protocol)
+	_ = d.Set("net_type", domain.NetType)
+	_ = d.Set("certificate_id", domain.CertificateId)
+	_ = d.Set("is_default_mapping", domain.IsDefaultMapping)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, subDomain, err := parseApiGatewayCustomDomainId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewModifyCustomDomainRequest()
+	request.ServiceId = &serviceId
+	request.SubDomain = &subDomain
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.NetType = helper.String(d.Get("net_type").(string))
+	request.IsDefaultMapping = helper.Bool(d.Get("is_default_mapping").(bool))
+	if v, ok := d.GetOk("certificate_id"); ok {
+		request.CertificateId = helper.String(v.(string))
+	}
+	request.PathMappingSet = apiGatewayPathMappings(d)
+
+	if err := apiGatewayService.ModifyCustomDomain(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s update API gateway custom domain failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudAPIGatewayCustomDomainRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, subDomain, err := parseApiGatewayCustomDomainId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.DeleteCustomDomain(ctx, serviceId, subDomain); err != nil {
+		log.Printf("[CRITAL]%s delete API gateway custom domain failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// +build tencentcloud
+
+/*
+Use this resource to create an IP allow/deny strategy of API gateway.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_ip_strategy" "strategy" {
+  service_id    = tencentcloud_api_gateway_service.service.id
+  strategy_name = "tf_example_strategy"
+  strategy_type = "WHITE"
+  strategy_data = "10.0.0.1,10.0.0.2"
+}
+```
+
+Import
+
+API gateway IP strategy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_ip_strategy.strategy service-pg6ud8pa#IPStrategy-4w4v1bno
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayIPStrategy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayIPStrategyCreate,
+		Read:   resourceTencentCloudAPIGatewayIPStrategyRead,
+		Update: resourceTencentCloudAPIGatewayIPStrategyUpdate,
+		Delete: resourceTencentCloudAPIGatewayIPStrategyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway service that this IP strategy belongs to.",
+			},
+			"strategy_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom strategy name.",
+			},
+			"strategy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"WHITE", "BLACK"}),
+				Description:  "Strategy type, `WHITE` for allow list, `BLACK` for deny list.",
+			},
+			"strategy_data": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Comma separated list of IPs or CIDR blocks covered by this strategy.",
+			},
+		},
+	}
+}
+
+func apiGatewayIPStrategyId(serviceId, strategyId string) string {
+	return serviceId + FILED_SP + strategyId
+}
+
+func parseApiGatewayIPStrategyId(id string) (serviceId, strategyId string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid API gateway IP strategy id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudAPIGatewayIPStrategyCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+
+	request := apigateway.NewCreateIPStrategyRequest()
+	request.ServiceId = &serviceId
+	request.StrategyName = helper.String(d.Get("strategy_name").(string))
+	request.StrategyType = helper.String(d.Get("strategy_type").(string))
+	request.StrategyData = helper.String(d.Get("strategy_data").(string))
+
+	strategyId, err := apiGatewayService.CreateIPStrategy(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create API gateway IP strategy failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayIPStrategyId(serviceId, strategyId))
+
+	return resourceTencentCloudAPIGatewayIPStrategyRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayIPStrategyRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, strategyId, err := parseApiGatewayIPStrategyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	strategy, err := apiGatewayService.DescribeIPStrategyById(ctx, serviceId, strategyId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway IP strategy failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the strategy has been deleted out-of-band, recreate it on next apply
+	if strategy == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_id", serviceId)
+	_ = d.Set("strategy_name", strategy.StrategyName)
+	_ = d.Set("strategy_type", strategy.StrategyType)
+	_ = d.Set("strategy_data", strategy.StrategyData)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayIPStrategyUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, strategyId, err := parseApiGatewayIPStrategyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewModifyIPStrategyRequest()
+	request.ServiceId = &serviceId
+	request.StrategyId = &strategyId
+	request.StrategyData = helper.String(d.Get("strategy_data").(string))
+
+	if err := apiGatewayService.ModifyIPStrategy(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s update API gateway IP strategy failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudAPIGatewayIPStrategyRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayIPStrategyDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, strategyId, err := parseApiGatewayIPStrategyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.DeleteIPStrategy(ctx, serviceId, strategyId); err != nil {
+		log.Printf("[CRITAL]%s delete API gateway IP strategy failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
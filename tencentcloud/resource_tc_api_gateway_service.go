@@ -0,0 +1,212 @@
+// +build tencentcloud
+
+/*
+Use this resource to create API gateway service.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_service" "service" {
+  service_name = "tf-api-service"
+  protocol     = "http&https"
+  service_desc = "This is my API gateway service"
+  net_type     = ["INNER", "OUTER"]
+  ip_version   = "IPv4"
+}
+```
+
+Import
+
+API gateway service can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_service.service service-pg6ud8pa
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayServiceCreate,
+		Read:   resourceTencentCloudAPIGatewayServiceRead,
+		Update: resourceTencentCloudAPIGatewayServiceUpdate,
+		Delete: resourceTencentCloudAPIGatewayServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Custom service name.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"http", "https", "http&https"}),
+				Description:  "Service frontend request type, and the available values include `http`, `https` and `http&https`.",
+			},
+			"service_desc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom service description.",
+			},
+			"net_type": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Network type list, which is used to specify the supported network types, values include `INNER` and `OUTER`.",
+			},
+			"ip_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IPv4",
+				ValidateFunc: validateAllowedStringValue([]string{"IPv4", "IPv6"}),
+				Description:  "IP version number, default value is `IPv4`.",
+			},
+
+			// Computed values
+			"internal_sub_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Private network access subdomain name.",
+			},
+			"outer_sub_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "External network access subdomain name.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the API gateway service.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewCreateServiceRequest()
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.IpVersion = helper.String(d.Get("ip_version").(string))
+	if v, ok := d.GetOk("service_name"); ok {
+		request.ServiceName = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("service_desc"); ok {
+		request.ServiceDesc = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("net_type"); ok {
+		request.NetTypes = helper.InterfacesStrings(v.([]interface{}))
+	}
+
+	serviceId, err := apiGatewayService.CreateService(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(serviceId)
+
+	return resourceTencentCloudAPIGatewayServiceRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	service, err := apiGatewayService.DescribeServiceById(ctx, serviceId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the service has been deleted out-of-band, recreate it on next apply
+	if service == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_name", service.ServiceName)
+	_ = d.Set("protocol", service.Protocol)
+	_ = d.Set("service_desc", service.ServiceDesc)
+	_ = d.Set("net_type", service.NetTypes)
+	_ = d.Set("ip_version", service.IpVersion)
+	_ = d.Set("internal_sub_domain", service.InternalSubDomain)
+	_ = d.Set("outer_sub_domain", service.OuterSubDomain)
+	_ = d.Set("create_time", service.CreatedTime)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId := d.Id()
+
+	if d.HasChange("service_name") || d.HasChange("protocol") || d.HasChange("service_desc") || d.HasChange("net_type") {
+		apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+		request := apigateway.NewModifyServiceRequest()
+		request.ServiceId = &serviceId
+		request.ServiceName = helper.String(d.Get("service_name").(string))
+		request.Protocol = helper.String(d.Get("protocol").(string))
+		request.ServiceDesc = helper.String(d.Get("service_desc").(string))
+		if v, ok := d.GetOk("net_type"); ok {
+			request.NetTypes = helper.InterfacesStrings(v.([]interface{}))
+		}
+
+		if err := apiGatewayService.ModifyService(ctx, request); err != nil {
+			log.Printf("[CRITAL]%s update API gateway service failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	return resourceTencentCloudAPIGatewayServiceRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := apiGatewayService.DeleteService(ctx, serviceId); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
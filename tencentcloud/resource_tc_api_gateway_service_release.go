@@ -0,0 +1,186 @@
+// +build tencentcloud
+
+/*
+Use this resource to release an API gateway service to an environment
+(`test`, `prepub` or `release`), making its current API configuration live.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_service_release" "release" {
+  service_id       = tencentcloud_api_gateway_service.service.id
+  environment_name = "release"
+  release_desc     = "release for tf example"
+}
+```
+
+Import
+
+API gateway service release can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_service_release.release service-pg6ud8pa#release
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTencentCloudAPIGatewayServiceRelease() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayServiceReleaseCreate,
+		Read:   resourceTencentCloudAPIGatewayServiceReleaseRead,
+		Update: resourceTencentCloudAPIGatewayServiceReleaseUpdate,
+		Delete: resourceTencentCloudAPIGatewayServiceReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway service to release.",
+			},
+			"environment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"test", "prepub", "release"}),
+				Description:  "Environment to release to, valid values are `test`, `prepub` and `release`.",
+			},
+			"release_desc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Release description.",
+			},
+
+			// Computed values
+			"release_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version number of the current release.",
+			},
+		},
+	}
+}
+
+func apiGatewayServiceReleaseId(serviceId, environmentName string) string {
+	return serviceId + FILED_SP + environmentName
+}
+
+func parseApiGatewayServiceReleaseId(id string) (serviceId, environmentName string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid API gateway service release id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudAPIGatewayServiceReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service_release.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+	environmentName := d.Get("environment_name").(string)
+	releaseDesc := d.Get("release_desc").(string)
+
+	if err := apiGatewayService.ReleaseService(ctx, serviceId, environmentName, releaseDesc); err != nil {
+		log.Printf("[CRITAL]%s release API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayServiceReleaseId(serviceId, environmentName))
+
+	return resourceTencentCloudAPIGatewayServiceReleaseRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayServiceReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service_release.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, environmentName, err := parseApiGatewayServiceReleaseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	env, err := apiGatewayService.DescribeServiceEnvironmentStatus(ctx, serviceId, environmentName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway service release failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the service has never been released to this environment, recreate it on next apply
+	if env == nil || env.VersionName == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_id", serviceId)
+	_ = d.Set("environment_name", environmentName)
+	_ = d.Set("release_version", env.VersionName)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayServiceReleaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service_release.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, environmentName, err := parseApiGatewayServiceReleaseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("release_desc") {
+		apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+		releaseDesc := d.Get("release_desc").(string)
+		if err := apiGatewayService.ReleaseService(ctx, serviceId, environmentName, releaseDesc); err != nil {
+			log.Printf("[CRITAL]%s update API gateway service release failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	return resourceTencentCloudAPIGatewayServiceReleaseRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayServiceReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_service_release.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, environmentName, err := parseApiGatewayServiceReleaseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.UnReleaseService(ctx, serviceId, environmentName); err != nil {
+		log.Printf("[CRITAL]%s unrelease API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTencentCloudAPIGatewayService_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAPIGatewayServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIGatewayService,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIGatewayServiceExists("tencentcloud_api_gateway_service.service"),
+					resource.TestCheckResourceAttr("tencentcloud_api_gateway_service.service", "service_name", "tf-api-service"),
+					resource.TestCheckResourceAttr("tencentcloud_api_gateway_service.service", "protocol", "http&https"),
+					resource.TestCheckResourceAttr("tencentcloud_api_gateway_service.service", "ip_version", "IPv4"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_api_gateway_service.service",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAPIGatewayServiceDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	apiGatewayService := ApiGatewayService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_api_gateway_service" {
+			continue
+		}
+
+		service, err := apiGatewayService.DescribeServiceById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if service != nil {
+			return fmt.Errorf("API gateway service still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAPIGatewayServiceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource %s id is not set", n)
+		}
+
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		apiGatewayService := ApiGatewayService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+		service, err := apiGatewayService.DescribeServiceById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if service == nil {
+			return fmt.Errorf("API gateway service %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAPIGatewayService = `
+resource "tencentcloud_api_gateway_service" "service" {
+  service_name = "tf-api-service"
+  protocol     = "http&https"
+  service_desc = "This is my API gateway service"
+  net_type     = ["INNER", "OUTER"]
+  ip_version   = "IPv4"
+}
+`
@@ -0,0 +1,167 @@
+// +build tencentcloud
+
+/*
+Use this resource to bind an IP strategy of API gateway to a set of APIs within
+a released service environment.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_strategy_attachment" "attachment" {
+  service_id       = tencentcloud_api_gateway_service.service.id
+  strategy_id      = tencentcloud_api_gateway_ip_strategy.strategy.id
+  environment_name = "release"
+  bind_api_ids     = [tencentcloud_api_gateway_api.api.id]
+}
+```
+
+Import
+
+API gateway IP strategy attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_strategy_attachment.attachment service-pg6ud8pa#IPStrategy-4w4v1bno#release
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayStrategyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayStrategyAttachmentCreate,
+		Read:   resourceTencentCloudAPIGatewayStrategyAttachmentRead,
+		Delete: resourceTencentCloudAPIGatewayStrategyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway service.",
+			},
+			"strategy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the IP strategy to attach.",
+			},
+			"environment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"test", "prepub", "release"}),
+				Description:  "Environment name, valid values are `test`, `prepub` and `release`.",
+			},
+			"bind_api_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of API ids the strategy is attached to.",
+			},
+		},
+	}
+}
+
+func apiGatewayStrategyAttachmentId(serviceId, strategyId, environmentName string) string {
+	return strings.Join([]string{serviceId, strategyId, environmentName}, FILED_SP)
+}
+
+func parseApiGatewayStrategyAttachmentId(id string) (serviceId, strategyId, environmentName string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid API gateway strategy attachment id: %s", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func resourceTencentCloudAPIGatewayStrategyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_strategy_attachment.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	serviceId := d.Get("service_id").(string)
+	strategyId := d.Get("strategy_id").(string)
+	environmentName := d.Get("environment_name").(string)
+	apiIds := helper.InterfacesStrings(d.Get("bind_api_ids").([]interface{}))
+
+	if err := apiGatewayService.BindIPStrategy(ctx, serviceId, strategyId, environmentName, apiIds); err != nil {
+		log.Printf("[CRITAL]%s attach API gateway IP strategy failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayStrategyAttachmentId(serviceId, strategyId, environmentName))
+
+	return resourceTencentCloudAPIGatewayStrategyAttachmentRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayStrategyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_strategy_attachment.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, strategyId, environmentName, err := parseApiGatewayStrategyAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	strategy, err := apiGatewayService.DescribeIPStrategyById(ctx, serviceId, strategyId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway strategy attachment failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the strategy has been removed out-of-band, recreate it on next apply
+	if strategy == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("service_id", serviceId)
+	_ = d.Set("strategy_id", strategyId)
+	_ = d.Set("environment_name", environmentName)
+	_ = d.Set("bind_api_ids", strategy.BindApiIds)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayStrategyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_strategy_attachment.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	serviceId, strategyId, environmentName, err := parseApiGatewayStrategyAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	apiIds := helper.InterfacesStrings(d.Get("bind_api_ids").([]interface{}))
+
+	if err := apiGatewayService.UnBindIPStrategy(ctx, serviceId, strategyId, environmentName, apiIds); err != nil {
+		log.Printf("[CRITAL]%s detach API gateway IP strategy failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
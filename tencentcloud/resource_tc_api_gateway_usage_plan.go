@@ -0,0 +1,184 @@
+// +build tencentcloud
+
+/*
+Use this resource to create a usage plan of API gateway, used to throttle and
+meter traffic for bound environments/APIs.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_usage_plan" "plan" {
+  usage_plan_name         = "tf_example_plan"
+  usage_plan_desc         = "my usage plan"
+  max_request_num         = 100000
+  max_request_num_pre_sec = 100
+}
+```
+
+Import
+
+API gateway usage plan can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_usage_plan.plan usagePlan-gyeafpab
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayUsagePlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayUsagePlanCreate,
+		Read:   resourceTencentCloudAPIGatewayUsagePlanRead,
+		Update: resourceTencentCloudAPIGatewayUsagePlanUpdate,
+		Delete: resourceTencentCloudAPIGatewayUsagePlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"usage_plan_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom usage plan name.",
+			},
+			"usage_plan_desc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom usage plan description.",
+			},
+			"max_request_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     -1,
+				Description: "Total number of requests allowed, `-1` means no limit. Default is `-1`.",
+			},
+			"max_request_num_pre_sec": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "Limit of requests per second, `-1` means no limit. Default is `1000`.",
+			},
+
+			// Computed values
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the usage plan.",
+			},
+			"modify_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modify time of the usage plan.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewCreateUsagePlanRequest()
+	request.UsagePlanName = helper.String(d.Get("usage_plan_name").(string))
+	request.UsagePlanDesc = helper.String(d.Get("usage_plan_desc").(string))
+	request.MaxRequestNum = helper.IntInt64(d.Get("max_request_num").(int))
+	request.MaxRequestNumPreSec = helper.IntInt64(d.Get("max_request_num_pre_sec").(int))
+
+	usagePlanId, err := apiGatewayService.CreateUsagePlan(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create API gateway usage plan failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(usagePlanId)
+
+	return resourceTencentCloudAPIGatewayUsagePlanRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	usagePlan, err := apiGatewayService.DescribeUsagePlanById(ctx, usagePlanId)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway usage plan failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the usage plan has been deleted out-of-band, recreate it on next apply
+	if usagePlan == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("usage_plan_name", usagePlan.UsagePlanName)
+	_ = d.Set("usage_plan_desc", usagePlan.UsagePlanDesc)
+	_ = d.Set("max_request_num", usagePlan.MaxRequestNum)
+	_ = d.Set("max_request_num_pre_sec", usagePlan.MaxRequestNumPreSec)
+	_ = d.Set("create_time", usagePlan.CreatedTime)
+	_ = d.Set("modify_time", usagePlan.ModifiedTime)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewModifyUsagePlanRequest()
+	request.UsagePlanId = &usagePlanId
+	request.UsagePlanName = helper.String(d.Get("usage_plan_name").(string))
+	request.UsagePlanDesc = helper.String(d.Get("usage_plan_desc").(string))
+	request.MaxRequestNum = helper.IntInt64(d.Get("max_request_num").(int))
+	request.MaxRequestNumPreSec = helper.IntInt64(d.Get("max_request_num_pre_sec").(int))
+
+	if err := apiGatewayService.ModifyUsagePlan(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s update API gateway usage plan failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudAPIGatewayUsagePlanRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId := d.Id()
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := apiGatewayService.DeleteUsagePlan(ctx, usagePlanId); err != nil {
+		log.Printf("[CRITAL]%s delete API gateway usage plan failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+// +build tencentcloud
+
+/*
+Use this resource to bind a usage plan of API gateway to a released service
+environment, either at the whole-service level or for a specific set of APIs.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_api_gateway_usage_plan_attachment" "attachment" {
+  usage_plan_id    = tencentcloud_api_gateway_usage_plan.plan.id
+  service_id       = tencentcloud_api_gateway_service.service.id
+  environment_name = "release"
+  bind_type        = "API"
+  api_ids          = [tencentcloud_api_gateway_api.api.id]
+}
+```
+
+Import
+
+API gateway usage plan attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_usage_plan_attachment.attachment usagePlan-gyeafpab#service-pg6ud8pa#release#API
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAPIGatewayUsagePlanAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate,
+		Read:   resourceTencentCloudAPIGatewayUsagePlanAttachmentRead,
+		Delete: resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"usage_plan_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the usage plan.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API gateway service.",
+			},
+			"environment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"test", "prepub", "release"}),
+				Description:  "Environment name, valid values are `test`, `prepub` and `release`.",
+			},
+			"bind_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SERVICE",
+				ValidateFunc: validateAllowedStringValue([]string{"SERVICE", "API"}),
+				Description:  "Binding type, `SERVICE` binds the whole service, `API` binds a set of APIs given by `api_ids`. Default is `SERVICE`.",
+			},
+			"api_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of API ids to bind, required when `bind_type` is `API`.",
+			},
+		},
+	}
+}
+
+func apiGatewayUsagePlanAttachmentId(usagePlanId, serviceId, environmentName, bindType string) string {
+	return strings.Join([]string{usagePlanId, serviceId, environmentName, bindType}, FILED_SP)
+}
+
+func parseApiGatewayUsagePlanAttachmentId(id string) (usagePlanId, serviceId, environmentName, bindType string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("invalid API gateway usage plan attachment id: %s", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan_attachment.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	usagePlanId := d.Get("usage_plan_id").(string)
+	serviceId := d.Get("service_id").(string)
+	environmentName := d.Get("environment_name").(string)
+	bindType := d.Get("bind_type").(string)
+
+	request := apigateway.NewBindEnvironmentRequest()
+	request.UsagePlanId = &usagePlanId
+	request.ServiceId = &serviceId
+	request.EnvironmentName = &environmentName
+	request.BindType = &bindType
+	if v, ok := d.GetOk("api_ids"); ok {
+		request.ApiIds = helper.InterfacesStrings(v.([]interface{}))
+	}
+
+	if err := apiGatewayService.BindEnvironment(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s attach API gateway usage plan failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(apiGatewayUsagePlanAttachmentId(usagePlanId, serviceId, environmentName, bindType))
+
+	return resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d, meta)
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan_attachment.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId, serviceId, environmentName, bindType, err := parseApiGatewayUsagePlanAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	envs, err := apiGatewayService.DescribeUsagePlanEnvironments(ctx, usagePlanId, bindType)
+	if err != nil {
+		log.Printf("[CRITAL]%s read API gateway usage plan attachment failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	found := false
+	for _, env := range envs {
+		if env.ServiceId != nil && *env.ServiceId == serviceId &&
+			env.EnvironmentName != nil && *env.EnvironmentName == environmentName {
+			found = true
+			break
+		}
+	}
+	//the attachment has been removed out-of-band, recreate it on next apply
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("usage_plan_id", usagePlanId)
+	_ = d.Set("service_id", serviceId)
+	_ = d.Set("environment_name", environmentName)
+	_ = d.Set("bind_type", bindType)
+
+	return nil
+}
+
+func resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan_attachment.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	usagePlanId, serviceId, environmentName, bindType, err := parseApiGatewayUsagePlanAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	apiGatewayService := ApiGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := apigateway.NewUnBindEnvironmentRequest()
+	request.UsagePlanId = &usagePlanId
+	request.ServiceId = &serviceId
+	request.EnvironmentName = &environmentName
+	request.BindType = &bindType
+	if v, ok := d.GetOk("api_ids"); ok {
+		request.ApiIds = helper.InterfacesStrings(v.([]interface{}))
+	}
+
+	if err := apiGatewayService.UnBindEnvironment(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s detach API gateway usage plan failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
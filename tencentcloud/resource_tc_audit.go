@@ -0,0 +1,217 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a CloudAudit trail that delivers API call logs to a COS bucket.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_audit" "foo" {
+  name                 = "tf-example-audit"
+  cos_bucket           = "audit-example-1234567890"
+  cos_region           = "ap-guangzhou"
+  log_file_prefix      = "audit-log"
+  read_write_attribute = 3
+  audit_switch         = true
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	cloudaudit "github.com/tencentyun/tcecloud-sdk-go/tcecloud/cloudaudit/v20190304"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAudit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAuditCreate,
+		Read:   resourceTencentCloudAuditRead,
+		Update: resourceTencentCloudAuditUpdate,
+		Delete: resourceTencentCloudAuditDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the audit trail.",
+			},
+			"cos_bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the COS bucket (without the `-appid` suffix) that receives the audit logs.",
+			},
+			"cos_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Region of the COS bucket that receives the audit logs.",
+			},
+			"log_file_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix of the audit log file name stored in the COS bucket.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the KMS CMK used to encrypt the audit logs. If not set, the logs are stored unencrypted.",
+			},
+			"read_write_attribute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3}),
+				Description:  "Attribute of the events to record, `1` for write-only, `2` for read-only, `3` for read and write. Default is `3`.",
+			},
+			"audit_switch": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates whether the audit trail is enabled. Default is `true`.",
+			},
+
+			// Computed values
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the audit trail.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudAuditCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_audit.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	name := d.Get("name").(string)
+
+	request := cloudaudit.NewCreateAuditRequest()
+	request.Name = &name
+	request.CosBucket = helper.String(d.Get("cos_bucket").(string))
+	request.CosRegion = helper.String(d.Get("cos_region").(string))
+	request.ReadWriteAttribute = helper.IntUint64(d.Get("read_write_attribute").(int))
+
+	if v, ok := d.GetOk("log_file_prefix"); ok {
+		request.LogFilePrefix = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("key_id"); ok {
+		request.KeyId = helper.String(v.(string))
+		request.IsEnableKmsEncry = helper.IntUint64(1)
+	} else {
+		request.IsEnableKmsEncry = helper.IntUint64(0)
+	}
+	if d.Get("audit_switch").(bool) {
+		request.IsEnableAudit = helper.IntUint64(1)
+	} else {
+		request.IsEnableAudit = helper.IntUint64(0)
+	}
+
+	if err := auditService.CreateAudit(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s create audit trail failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(name)
+
+	return resourceTencentCloudAuditRead(d, meta)
+}
+
+func resourceTencentCloudAuditRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_audit.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	audit, err := auditService.DescribeAuditById(ctx, d.Id())
+	if err != nil {
+		log.Printf("[CRITAL]%s read audit trail failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if audit == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", audit.AuditName)
+	_ = d.Set("cos_bucket", audit.CosBucket)
+	_ = d.Set("cos_region", audit.CosRegion)
+	_ = d.Set("log_file_prefix", audit.LogFilePrefix)
+	_ = d.Set("key_id", audit.KeyId)
+	if audit.ReadWriteAttribute != nil {
+		_ = d.Set("read_write_attribute", audit.ReadWriteAttribute)
+	}
+	if audit.IsEnabled != nil {
+		_ = d.Set("audit_switch", *audit.IsEnabled == int64(1))
+	}
+	_ = d.Set("create_time", audit.CreateTime)
+
+	return nil
+}
+
+func resourceTencentCloudAuditUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_audit.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	name := d.Id()
+
+	request := cloudaudit.NewModifyAuditRequest()
+	request.Name = &name
+	request.CosBucket = helper.String(d.Get("cos_bucket").(string))
+	request.CosRegion = helper.String(d.Get("cos_region").(string))
+	request.ReadWriteAttribute = helper.IntUint64(d.Get("read_write_attribute").(int))
+
+	if v, ok := d.GetOk("log_file_prefix"); ok {
+		request.LogFilePrefix = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("key_id"); ok {
+		request.KeyId = helper.String(v.(string))
+		request.IsEnableKmsEncry = helper.IntUint64(1)
+	} else {
+		request.IsEnableKmsEncry = helper.IntUint64(0)
+	}
+	if d.Get("audit_switch").(bool) {
+		request.IsEnableAudit = helper.IntUint64(1)
+	} else {
+		request.IsEnableAudit = helper.IntUint64(0)
+	}
+
+	if err := auditService.ModifyAudit(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s update audit trail failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudAuditRead(d, meta)
+}
+
+func resourceTencentCloudAuditDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_audit.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	auditService := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := auditService.DeleteAudit(ctx, d.Id()); err != nil {
+		log.Printf("[CRITAL]%s delete audit trail failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
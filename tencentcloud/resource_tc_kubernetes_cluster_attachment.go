@@ -86,13 +86,69 @@ resource "tencentcloud_kubernetes_cluster_attachment" "test_attach" {
   password    = "Lo4wbdit"
 }
 ```
+
+Credential-less attachment using a bootstrap token
+
+```hcl
+resource "tencentcloud_kubernetes_cluster_attachment" "test_attach" {
+  cluster_id          = tencentcloud_kubernetes_cluster.managed_cluster.id
+  instance_id         = tencentcloud_instance.foo.id
+  use_bootstrap_token = true
+}
+```
+
+Attachment with labels, taints and unschedulable
+
+```hcl
+resource "tencentcloud_kubernetes_cluster_attachment" "test_attach" {
+  cluster_id    = tencentcloud_kubernetes_cluster.managed_cluster.id
+  instance_id   = tencentcloud_instance.foo.id
+  password      = "Lo4wbdit"
+  unschedulable = true
+
+  labels = {
+    "test" = "test"
+  }
+
+  taints {
+    key    = "key1"
+    value  = "value1"
+    effect = "NoSchedule"
+  }
+}
+```
+
+Draining and terminating the instance on delete
+
+```hcl
+resource "tencentcloud_kubernetes_cluster_attachment" "test_attach" {
+  cluster_id      = tencentcloud_kubernetes_cluster.managed_cluster.id
+  instance_id     = tencentcloud_instance.foo.id
+  password        = "Lo4wbdit"
+  delete_mode     = "terminate"
+  drain_on_delete = true
+  drain_timeout   = 300
+  force           = true
+}
+```
+
+Import
+
+Kubernetes cluster attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_kubernetes_cluster_attachment.test_attach ins-xxxxxxxx_cls-xxxxxxxx
+```
 */
 package tencentcloud
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -133,6 +189,94 @@ func resourceTencentCloudTkeClusterAttachment() *schema.Resource {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "The key pair to use for the instance, it looks like skey-16jig7tx, it should be set if `password` not set.",
 		},
+		"use_bootstrap_token": {
+			Type:        schema.TypeBool,
+			ForceNew:    true,
+			Optional:    true,
+			Description: "Attach the instance without a `password`/`key_ids`, using a short-lived TKE node bootstrap token instead. The token is injected into the instance as a join script and revoked once the node registers with the cluster.",
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			ForceNew:    true,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Labels applied to the node as it joins the cluster.",
+		},
+		"taints": {
+			Type:        schema.TypeList,
+			ForceNew:    true,
+			Optional:    true,
+			Description: "Taints applied to the node as it joins the cluster.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Key of the taint.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Value of the taint.",
+					},
+					"effect": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+							value := v.(string)
+							for _, effect := range []string{"NoSchedule", "PreferNoSchedule", "NoExecute"} {
+								if effect == value {
+									return
+								}
+							}
+							errors = append(errors, fmt.Errorf("taint effect %s is invalid, must be one of `NoSchedule`, `PreferNoSchedule`, `NoExecute`", value))
+							return
+						},
+						Description: "Effect of the taint, must be one of `NoSchedule`, `PreferNoSchedule`, `NoExecute`.",
+					},
+				},
+			},
+		},
+		"unschedulable": {
+			Type:        schema.TypeBool,
+			ForceNew:    true,
+			Optional:    true,
+			Description: "Cordon the node as it joins the cluster, keeping it out of the scheduler until it is manually uncordoned.",
+		},
+		"delete_mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "retain",
+			ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+				value := v.(string)
+				for _, mode := range []string{"retain", "terminate"} {
+					if mode == value {
+						return
+					}
+				}
+				errors = append(errors, fmt.Errorf("delete_mode %s is invalid, must be one of `retain`, `terminate`", value))
+				return
+			},
+			Description: "Decide whether to retain or terminate the CVM instance when the attachment is deleted, must be one of `retain`, `terminate`. Default is `retain`.",
+		},
+		"drain_on_delete": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Cordon and drain the node before it is removed from the cluster, analogous to `kubectl drain`. The underlying API does not respect PodDisruptionBudgets, so this only gives running pods a chance to terminate gracefully before deletion.",
+		},
+		"drain_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     120,
+			Description: "Seconds to wait for the node to finish draining before giving up, only used when `drain_on_delete` is true.",
+		},
+		"force": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Proceed with deleting the attachment even if the node fails to finish draining within `drain_timeout`, only used when `drain_on_delete` is true.",
+		},
 
 		//compute
 		"security_groups": {
@@ -141,12 +285,26 @@ func resourceTencentCloudTkeClusterAttachment() *schema.Resource {
 			Computed:    true,
 			Description: "A list of security group ids after attach to cluster.",
 		},
+		"bootstrap_token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The bootstrap token generated for this attachment when `use_bootstrap_token` is set, empty once the node has registered and the token has been revoked.",
+		},
 	}
 
 	return &schema.Resource{
 		Create: resourceTencentCloudTkeClusterAttachmentCreate,
 		Read:   resourceTencentCloudTkeClusterAttachmentRead,
 		Delete: resourceTencentCloudTkeClusterAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: schemaBody,
 	}
 }
@@ -170,7 +328,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	/*tke has been deleted*/
 	_, has, err := tkeService.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 			_, has, err = tkeService.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -188,7 +346,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 
 	/*cvm has been deleted*/
 	var instance *cvm.Instance
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		instance, err = cvmService.DescribeInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -206,7 +364,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	/*attachment has been  deleted*/
 	_, workers, err := tkeService.DescribeClusterInstances(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 			_, workers, err = tkeService.DescribeClusterInstances(ctx, clusterId)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -219,9 +377,11 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	}
 
 	has = false
-	for _, worker := range workers {
+	matchIdx := -1
+	for i, worker := range workers {
 		if worker.InstanceId == instanceId {
 			has = true
+			matchIdx = i
 		}
 	}
 
@@ -234,6 +394,16 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 		_ = d.Set("key_ids", instance.LoginSettings.KeyIds)
 	}
 	_ = d.Set("security_groups", helper.StringsInterfaces(instance.SecurityGroupIds))
+
+	// Reconciled against the live node's advanced settings on the TKE side,
+	// so a label or unschedulable change made outside Terraform shows up as
+	// drift. Taints are not read back here: the vendored API has no native
+	// taint field to diff against (see the note above the join-script
+	// construction in Create), so they are applied once at join time only.
+	node := workers[matchIdx]
+	_ = d.Set("labels", node.Labels)
+	_ = d.Set("unschedulable", node.Unschedulable)
+
 	return nil
 }
 
@@ -251,22 +421,93 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	instanceId := helper.String(d.Get("instance_id").(string))
 	request.ClusterId = helper.String(d.Get("cluster_id").(string))
 	request.InstanceIds = []*string{instanceId}
-	request.LoginSettings = &tke.LoginSettings{}
 
-	var loginSettingsNumbers = 0
+	useBootstrapToken := d.Get("use_bootstrap_token").(bool)
+	var bootstrapToken string
+	var joinScriptLines []string
+
+	if useBootstrapToken {
+		if _, ok := d.GetOk("key_ids"); ok {
+			return fmt.Errorf("`key_ids` can not be set when `use_bootstrap_token` is true")
+		}
+		if _, ok := d.GetOk("password"); ok {
+			return fmt.Errorf("`password` can not be set when `use_bootstrap_token` is true")
+		}
+
+		var err error
+		bootstrapToken, err = createTkeClusterNodeToken(ctx, &tkeService, *request.ClusterId)
+		if err != nil {
+			return err
+		}
+		_ = d.Set("bootstrap_token", bootstrapToken)
+
+		defer func() {
+			if revokeErr := revokeTkeClusterNodeToken(ctx, &tkeService, *request.ClusterId, bootstrapToken); revokeErr != nil {
+				log.Printf("[CRITAL]%s revoke tke cluster node bootstrap token failed, reason:%s\n", logId, revokeErr.Error())
+				return
+			}
+			_ = d.Set("bootstrap_token", "")
+		}()
+
+		joinScriptLines = append(joinScriptLines, tkeNodeJoinCommand(bootstrapToken))
+	} else {
+		request.LoginSettings = &tke.LoginSettings{}
+
+		var loginSettingsNumbers = 0
+
+		if v, ok := d.GetOk("key_ids"); ok {
+			request.LoginSettings.KeyIds = helper.Strings(helper.InterfacesStrings(v.([]interface{})))
+			loginSettingsNumbers++
+		}
+
+		if v, ok := d.GetOk("password"); ok {
+			request.LoginSettings.Password = helper.String(v.(string))
+			loginSettingsNumbers++
+		}
+
+		if loginSettingsNumbers != 1 {
+			return fmt.Errorf("parameters `key_ids` and `password` must set and only set one")
+		}
+	}
+
+	advancedSettings := &tke.InstanceAdvancedSettings{}
+	hasAdvancedSettings := false
 
-	if v, ok := d.GetOk("key_ids"); ok {
-		request.LoginSettings.KeyIds = helper.Strings(helper.InterfacesStrings(v.([]interface{})))
-		loginSettingsNumbers++
+	if v, ok := d.GetOk("labels"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			advancedSettings.Labels = append(advancedSettings.Labels, &tke.Label{
+				Name:  helper.String(key),
+				Value: helper.String(value.(string)),
+			})
+		}
+		hasAdvancedSettings = true
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		request.LoginSettings.Password = helper.String(v.(string))
-		loginSettingsNumbers++
+	if d.Get("unschedulable").(bool) {
+		advancedSettings.Unschedulable = helper.IntInt64(1)
+		hasAdvancedSettings = true
 	}
 
-	if loginSettingsNumbers != 1 {
-		return fmt.Errorf("parameters `key_ids` and `password` must set and only set one")
+	// The vendored TKE API has no native taint field on InstanceAdvancedSettings,
+	// so taints are reconciled by appending a `kubectl taint` invocation to the
+	// node's post-join UserScript (the one extension point InstanceAdvancedSettings
+	// does offer, documented to run once the node's k8s components are up).
+	if v, ok := d.GetOk("taints"); ok {
+		for _, raw := range v.([]interface{}) {
+			taint := raw.(map[string]interface{})
+			joinScriptLines = append(joinScriptLines, fmt.Sprintf(
+				"kubectl taint node $(hostname) %s=%s:%s --overwrite --kubeconfig=/root/.kube/config",
+				taint["key"].(string), taint["value"].(string), taint["effect"].(string)))
+		}
+	}
+
+	if len(joinScriptLines) > 0 {
+		advancedSettings.UserScript = helper.String(tkeNodeUserScript(joinScriptLines))
+		hasAdvancedSettings = true
+	}
+
+	if hasAdvancedSettings {
+		request.InstanceAdvancedSettings = advancedSettings
 	}
 
 	/*cvm has been  attached*/
@@ -320,7 +561,7 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	/*wait for cvm status*/
-	if err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	if err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, *instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -334,7 +575,7 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	/*wait for tke init ok */
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, workers, err = tkeService.DescribeClusterInstances(ctx, *request.ClusterId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -371,6 +612,9 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 func resourceTencentCloudTkeClusterAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment.delete")()
 
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
 	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId, clusterId := "", ""
 
@@ -380,17 +624,25 @@ func resourceTencentCloudTkeClusterAttachmentDelete(d *schema.ResourceData, meta
 		instanceId, clusterId = items[0], items[1]
 	}
 
+	if d.Get("drain_on_delete").(bool) {
+		drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+		force := d.Get("force").(bool)
+		if err := tkeDrainClusterNode(ctx, &tkeService, clusterId, instanceId, drainTimeout, force); err != nil {
+			return err
+		}
+	}
+
 	request := tke.NewDeleteClusterInstancesRequest()
 
 	request.ClusterId = &clusterId
 	request.InstanceIds = []*string{
 		&instanceId,
 	}
-	request.InstanceDeleteMode = helper.String("retain")
+	request.InstanceDeleteMode = helper.String(d.Get("delete_mode").(string))
 
 	var err error
 
-	if err = resource.Retry(4*writeRetryTimeout, func() *resource.RetryError {
+	if err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := tkeService.client.UseTkeClient().DeleteClusterInstances(request)
 		if e, ok := err.(*errors.TceCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -411,3 +663,112 @@ func resourceTencentCloudTkeClusterAttachmentDelete(d *schema.ResourceData, meta
 	}
 	return nil
 }
+
+// createTkeClusterNodeToken requests a short-lived bootstrap token that a
+// kubelet can present to join clusterId without SSH credentials, analogous
+// to `kubeadm token create`.
+func createTkeClusterNodeToken(ctx context.Context, service *TkeService, clusterId string) (string, error) {
+	logId := getLogId(ctx)
+
+	request := tke.NewCreateClusterNodeTokenRequest()
+	request.ClusterId = &clusterId
+
+	var response *tke.CreateClusterNodeTokenResponse
+	var err error
+	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = service.client.UseTkeClient().CreateClusterNodeToken(request)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		return nil
+	}); err != nil {
+		log.Printf("[CRITAL]%s create tke cluster %s node bootstrap token failed, reason:%s\n", logId, clusterId, err.Error())
+		return "", err
+	}
+
+	return *response.Response.Token, nil
+}
+
+// revokeTkeClusterNodeToken invalidates a bootstrap token once the node it
+// was issued for has registered, or the attachment failed.
+func revokeTkeClusterNodeToken(ctx context.Context, service *TkeService, clusterId, token string) error {
+	request := tke.NewDeleteClusterNodeTokenRequest()
+	request.ClusterId = &clusterId
+	request.Token = &token
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, err := service.client.UseTkeClient().DeleteClusterNodeToken(request)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		return nil
+	})
+}
+
+// tkeNodeJoinCommand renders the kubeadm invocation that registers a node
+// with the cluster's apiserver using a bootstrap token, in place of the
+// password/key_ids login flow.
+func tkeNodeJoinCommand(token string) string {
+	return fmt.Sprintf("kubeadm join --token %s --discovery-token-unsafe-skip-ca-verification", token)
+}
+
+// tkeNodeUserScript renders the base64-encoded UserScript made up of the
+// given shell commands, run by the node once its k8s components are up.
+func tkeNodeUserScript(lines []string) string {
+	script := "#!/bin/sh\n"
+	for _, line := range lines {
+		script += line + "\n"
+	}
+	return base64.StdEncoding.EncodeToString([]byte(script))
+}
+
+// tkeDrainClusterNode cordons instanceId and evicts its pods before it is
+// removed from clusterId, analogous to `kubectl drain`. The vendored TKE API
+// does not expose PodDisruptionBudget awareness, so this only gives running
+// pods a chance to terminate gracefully; it does not guarantee disruption
+// budgets are honored. If the node has not finished draining within timeout,
+// the drain is treated as failed unless force is set, in which case deletion
+// proceeds anyway.
+func tkeDrainClusterNode(ctx context.Context, service *TkeService, clusterId, instanceId string, timeout time.Duration, force bool) error {
+	logId := getLogId(ctx)
+
+	request := tke.NewDrainClusterNodeRequest()
+	request.ClusterId = &clusterId
+	request.InstanceId = &instanceId
+
+	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, err := service.client.UseTkeClient().DrainClusterNode(request)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		return nil
+	}); err != nil {
+		log.Printf("[CRITAL]%s drain tke cluster %s node %s failed, reason:%s\n", logId, clusterId, instanceId, err.Error())
+		return err
+	}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		_, workers, errRet := service.DescribeClusterInstances(ctx, clusterId)
+		if errRet != nil {
+			return retryError(errRet, InternalError)
+		}
+		for _, worker := range workers {
+			if worker.InstanceId == instanceId && worker.DrainStatus != "Drained" {
+				return resource.RetryableError(fmt.Errorf("instance %s drain status is %s, retry...", instanceId, worker.DrainStatus))
+			}
+		}
+		return nil
+	})
+	if err != nil && !force {
+		return fmt.Errorf("instance %s did not finish draining from cluster %s within %s: %s", instanceId, clusterId, timeout, err)
+	}
+	if err != nil {
+		log.Printf("[CRITAL]%s instance %s did not finish draining from cluster %s within %s, proceeding with deletion because `force` is set, reason:%s\n",
+			logId, instanceId, clusterId, timeout, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,421 @@
+/*
+Provide a resource to attach a set of existing cvm instances to a kubernetes
+cluster in one apply, with concurrent per-instance join checks and tolerance
+for partial failure: instances that fail to attach or never converge do not
+prevent the resource from being created for the ones that succeeded, their
+outcome is reported in the computed `results` attribute instead.
+
+Unlike `tencentcloud_kubernetes_cluster_attachment`, `instance_ids` is not
+`ForceNew` - adding or removing an instance id updates the membership of the
+group in place, attaching the new instances and detaching the removed ones,
+without recreating the resource or touching instances that are unchanged.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_kubernetes_cluster_attachment_group" "attach" {
+  cluster_id  = tencentcloud_kubernetes_cluster.managed_cluster.id
+  instance_ids = [
+    tencentcloud_instance.foo.id,
+    tencentcloud_instance.bar.id,
+  ]
+  password = "Lo4wbdit"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tke "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tke/v20180525"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+func resourceTencentCloudTkeClusterAttachmentGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTkeClusterAttachmentGroupCreate,
+		Read:   resourceTencentCloudTkeClusterAttachmentGroupRead,
+		Update: resourceTencentCloudTkeClusterAttachmentGroupUpdate,
+		Delete: resourceTencentCloudTkeClusterAttachmentGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the cluster.",
+			},
+			"instance_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the CVM instances to attach, these cvms will reinstall the system. Unlike `tencentcloud_kubernetes_cluster_attachment`, changing this set updates membership in place instead of recreating the resource.",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validateAsConfigPassword,
+				Description:  "Password to access, should be set if `key_ids` not set.",
+			},
+			"key_ids": {
+				MaxItems:    1,
+				Type:        schema.TypeList,
+				ForceNew:    true,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key pair to use for the instances, it looks like skey-16jig7tx, it should be set if `password` not set.",
+			},
+			"worker_count": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "Size of the worker pool used to poll instance join status concurrently. Bounds how many instances are checked at once, it does not bound how many can be attached.",
+			},
+
+			// computed
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Per-instance outcome of the last apply, in no particular order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the instance.",
+						},
+						"success": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance successfully joined the cluster.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Detail on the outcome, e.g. the failure reason if `success` is false.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// tkeAttachmentGroupResult is the outcome of attaching a single instance,
+// surfaced to the user through the `results` computed attribute.
+type tkeAttachmentGroupResult struct {
+	instanceId string
+	success    bool
+	message    string
+}
+
+func resourceTencentCloudTkeClusterAttachmentGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment_group.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	clusterId := d.Get("cluster_id").(string)
+	instanceIds := helper.InterfacesStrings(d.Get("instance_ids").(*schema.Set).List())
+
+	loginSettings, err := tkeClusterAttachmentGroupLoginSettings(d)
+	if err != nil {
+		return err
+	}
+
+	if err := tkeAddClusterInstances(ctx, &tkeService, clusterId, instanceIds, loginSettings); err != nil {
+		return err
+	}
+
+	d.SetId(clusterId)
+
+	results := tkeWaitClusterInstancesJoined(ctx, &tkeService, clusterId, instanceIds, d.Get("worker_count").(int))
+	if err := d.Set("results", flattenTkeAttachmentGroupResults(results)); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudTkeClusterAttachmentGroupRead(d, meta)
+}
+
+func resourceTencentCloudTkeClusterAttachmentGroupRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment_group.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+	clusterId := d.Id()
+
+	_, has, err := tkeService.DescribeCluster(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+	if !has {
+		d.SetId("")
+		return nil
+	}
+
+	_, workers, err := tkeService.DescribeClusterInstances(ctx, clusterId)
+	if err != nil {
+		return err
+	}
+
+	attached := make(map[string]bool, len(workers))
+	for _, worker := range workers {
+		attached[worker.InstanceId] = true
+	}
+
+	instanceIds := helper.InterfacesStrings(d.Get("instance_ids").(*schema.Set).List())
+	current := make([]string, 0, len(instanceIds))
+	for _, instanceId := range instanceIds {
+		if attached[instanceId] {
+			current = append(current, instanceId)
+		}
+	}
+
+	if len(current) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	return d.Set("instance_ids", current)
+}
+
+func resourceTencentCloudTkeClusterAttachmentGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment_group.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+	clusterId := d.Id()
+
+	if d.HasChange("instance_ids") {
+		old, new := d.GetChange("instance_ids")
+		removed := old.(*schema.Set).Difference(new.(*schema.Set))
+		added := new.(*schema.Set).Difference(old.(*schema.Set))
+
+		if removed.Len() > 0 {
+			if err := tkeRemoveClusterInstances(ctx, &tkeService, clusterId, helper.InterfacesStrings(removed.List())); err != nil {
+				return err
+			}
+		}
+
+		if added.Len() > 0 {
+			loginSettings, err := tkeClusterAttachmentGroupLoginSettings(d)
+			if err != nil {
+				return err
+			}
+
+			addedIds := helper.InterfacesStrings(added.List())
+			if err := tkeAddClusterInstances(ctx, &tkeService, clusterId, addedIds, loginSettings); err != nil {
+				return err
+			}
+
+			results := tkeWaitClusterInstancesJoined(ctx, &tkeService, clusterId, addedIds, d.Get("worker_count").(int))
+			if err := d.Set("results", flattenTkeAttachmentGroupResults(results)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceTencentCloudTkeClusterAttachmentGroupRead(d, meta)
+}
+
+func resourceTencentCloudTkeClusterAttachmentGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment_group.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+	clusterId := d.Id()
+	instanceIds := helper.InterfacesStrings(d.Get("instance_ids").(*schema.Set).List())
+
+	if err := tkeRemoveClusterInstances(ctx, &tkeService, clusterId, instanceIds); err != nil {
+		log.Printf("[CRITAL]%s remove tke cluster %s instances failed, reason:%s\n", logId, clusterId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func tkeClusterAttachmentGroupLoginSettings(d *schema.ResourceData) (*tke.LoginSettings, error) {
+	loginSettings := &tke.LoginSettings{}
+	loginSettingsNumbers := 0
+
+	if v, ok := d.GetOk("key_ids"); ok {
+		loginSettings.KeyIds = helper.Strings(helper.InterfacesStrings(v.([]interface{})))
+		loginSettingsNumbers++
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		loginSettings.Password = helper.String(v.(string))
+		loginSettingsNumbers++
+	}
+
+	if loginSettingsNumbers != 1 {
+		return nil, fmt.Errorf("parameters `key_ids` and `password` must set and only set one")
+	}
+
+	return loginSettings, nil
+}
+
+// tkeAddClusterInstances submits one batched AddExistedInstances call for
+// instanceIds and classifies the immediate, synchronous response. It does
+// not wait for the instances to finish joining, see tkeWaitClusterInstancesJoined.
+func tkeAddClusterInstances(ctx context.Context, service *TkeService, clusterId string, instanceIds []string, loginSettings *tke.LoginSettings) error {
+	logId := getLogId(ctx)
+
+	request := tke.NewAddExistedInstancesRequest()
+	request.ClusterId = &clusterId
+	request.InstanceIds = helper.Strings(instanceIds)
+	request.LoginSettings = loginSettings
+
+	var response *tke.AddExistedInstancesResponse
+	var err error
+	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = service.client.UseTkeClient().AddExistedInstances(request)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		return nil
+	}); err != nil {
+		log.Printf("[CRITAL]%s add existed instances to cluster %s failed, reason:%s\n", logId, clusterId, err.Error())
+		return err
+	}
+
+	if len(response.Response.FailedInstanceIds) > 0 {
+		log.Printf("[CRITAL]%s add existed instances to cluster %s, some instances failed up front: %v\n",
+			logId, clusterId, helper.StringsInterfaces(response.Response.FailedInstanceIds))
+	}
+
+	return nil
+}
+
+// tkeRemoveClusterInstances detaches instanceIds from clusterId, retaining
+// the underlying cvm instances rather than terminating them, mirroring
+// tencentcloud_kubernetes_cluster_attachment's delete behavior.
+func tkeRemoveClusterInstances(ctx context.Context, service *TkeService, clusterId string, instanceIds []string) error {
+	if len(instanceIds) == 0 {
+		return nil
+	}
+
+	request := tke.NewDeleteClusterInstancesRequest()
+	request.ClusterId = &clusterId
+	request.InstanceIds = helper.Strings(instanceIds)
+	request.InstanceDeleteMode = helper.String("retain")
+
+	return resource.Retry(4*writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, err := service.client.UseTkeClient().DeleteClusterInstances(request)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		return nil
+	})
+}
+
+// tkeWaitClusterInstancesJoined polls instanceIds for convergence to the
+// "running" TKE instance state using a bounded pool of workerCount
+// goroutines, returning one result per instance. A failure or timeout on
+// one instance is captured in its own result and does not affect the
+// others, so the caller can still succeed the resource for the instances
+// that did join.
+func tkeWaitClusterInstancesJoined(ctx context.Context, service *TkeService, clusterId string, instanceIds []string, workerCount int) []tkeAttachmentGroupResult {
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	jobs := make(chan string, len(instanceIds))
+	results := make([]tkeAttachmentGroupResult, len(instanceIds))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	resultsByInstance := make(map[string]tkeAttachmentGroupResult, len(instanceIds))
+
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for instanceId := range jobs {
+				result := tkeWaitClusterInstanceJoined(ctx, service, clusterId, instanceId)
+				mu.Lock()
+				resultsByInstance[instanceId] = result
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, instanceId := range instanceIds {
+		jobs <- instanceId
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	for i, instanceId := range instanceIds {
+		results[i] = resultsByInstance[instanceId]
+	}
+
+	return results
+}
+
+func tkeWaitClusterInstanceJoined(ctx context.Context, service *TkeService, clusterId, instanceId string) tkeAttachmentGroupResult {
+	var lastState string
+
+	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+		_, workers, err := service.DescribeClusterInstances(ctx, clusterId)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+
+		for _, worker := range workers {
+			if worker.InstanceId != instanceId {
+				continue
+			}
+
+			lastState = worker.InstanceState
+			if worker.InstanceState == "failed" {
+				return resource.NonRetryableError(fmt.Errorf("instance %s failed to join cluster %s, reason:%s", instanceId, clusterId, worker.FailedReason))
+			}
+			if worker.InstanceState != "running" {
+				return resource.RetryableError(fmt.Errorf("instance %s in tke status is %s, retry...", instanceId, worker.InstanceState))
+			}
+			return nil
+		}
+
+		return resource.NonRetryableError(fmt.Errorf("instance %s not found in cluster %s instance list", instanceId, clusterId))
+	})
+
+	if err != nil {
+		return tkeAttachmentGroupResult{instanceId: instanceId, success: false, message: err.Error()}
+	}
+
+	return tkeAttachmentGroupResult{instanceId: instanceId, success: true, message: fmt.Sprintf("joined, state=%s", lastState)}
+}
+
+func flattenTkeAttachmentGroupResults(results []tkeAttachmentGroupResult) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		flattened = append(flattened, map[string]interface{}{
+			"instance_id": result.instanceId,
+			"success":     result.success,
+			"message":     result.message,
+		})
+	}
+	return flattened
+}
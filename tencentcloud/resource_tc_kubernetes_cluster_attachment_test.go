@@ -0,0 +1,160 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTencentCloudKubernetesClusterAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesClusterAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKubernetesClusterAttachmentExists("tencentcloud_kubernetes_cluster_attachment.test_attach"),
+					resource.TestCheckResourceAttrSet("tencentcloud_kubernetes_cluster_attachment.test_attach", "security_groups.#"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_kubernetes_cluster_attachment.test_attach",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "key_ids"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesClusterAttachmentDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tkeService := TkeService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_kubernetes_cluster_attachment" {
+			continue
+		}
+
+		items := strings.Split(rs.Primary.ID, "_")
+		if len(items) != 2 {
+			return fmt.Errorf("the resource id is corrupted")
+		}
+		instanceId, clusterId := items[0], items[1]
+
+		_, workers, err := tkeService.DescribeClusterInstances(ctx, clusterId)
+		if err != nil {
+			return err
+		}
+		for _, worker := range workers {
+			if worker.InstanceId == instanceId {
+				return fmt.Errorf("instance %s is still attached to cluster %s", instanceId, clusterId)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckKubernetesClusterAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("kubernetes cluster attachment %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("kubernetes cluster attachment id is not set")
+		}
+
+		items := strings.Split(rs.Primary.ID, "_")
+		if len(items) != 2 {
+			return fmt.Errorf("the resource id is corrupted")
+		}
+		instanceId, clusterId := items[0], items[1]
+
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), "logId", logId)
+
+		tkeService := TkeService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+		_, workers, err := tkeService.DescribeClusterInstances(ctx, clusterId)
+		if err != nil {
+			return err
+		}
+		for _, worker := range workers {
+			if worker.InstanceId == instanceId {
+				return nil
+			}
+		}
+		return fmt.Errorf("instance %s is not attached to cluster %s", instanceId, clusterId)
+	}
+}
+
+const testAccKubernetesClusterAttachmentConfig = `
+variable "availability_zone" {
+  default = "ap-guangzhou-3"
+}
+
+data "tencentcloud_images" "default" {
+  image_type = ["PUBLIC_IMAGE"]
+  os_name    = "centos"
+}
+
+data "tencentcloud_vpc_subnets" "vpc" {
+  is_default        = true
+  availability_zone = var.availability_zone
+}
+
+resource "tencentcloud_instance" "foo" {
+  instance_name     = "ci-test-tke-attach"
+  availability_zone = var.availability_zone
+  image_id          = data.tencentcloud_images.default.images.0.image_id
+  instance_type     = "SA1.LARGE8"
+  system_disk_type  = "CLOUD_PREMIUM"
+  system_disk_size  = 50
+}
+
+resource "tencentcloud_kubernetes_cluster" "managed_cluster" {
+  vpc_id                  = data.tencentcloud_vpc_subnets.vpc.instance_list.0.vpc_id
+  cluster_cidr            = "10.1.0.0/16"
+  cluster_max_pod_num     = 32
+  cluster_name            = "ci-test-tke-attach"
+  cluster_desc            = "test cluster desc"
+  cluster_max_service_num = 32
+
+  worker_config {
+    count                      = 1
+    availability_zone          = var.availability_zone
+    instance_type              = "SA1.LARGE8"
+    system_disk_type           = "CLOUD_SSD"
+    system_disk_size           = 60
+    internet_charge_type       = "TRAFFIC_POSTPAID_BY_HOUR"
+    internet_max_bandwidth_out = 100
+    public_ip_assigned         = true
+    subnet_id                  = data.tencentcloud_vpc_subnets.vpc.instance_list.0.subnet_id
+
+    data_disk {
+      disk_type = "CLOUD_PREMIUM"
+      disk_size = 50
+    }
+
+    enhanced_security_service = false
+    enhanced_monitor_service  = false
+    user_data                 = "dGVzdA=="
+    password                  = "ZZXXccvv1212"
+  }
+
+  cluster_deploy_type = "MANAGED_CLUSTER"
+}
+
+resource "tencentcloud_kubernetes_cluster_attachment" "test_attach" {
+  cluster_id  = tencentcloud_kubernetes_cluster.managed_cluster.id
+  instance_id = tencentcloud_instance.foo.id
+  password    = "Lo4wbdit"
+}
+`
@@ -0,0 +1,152 @@
+// +build tencentcloud
+
+/*
+Provides a resource to enable envelope encryption of Kubernetes secrets for a
+TKE cluster using a KMS CMK.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_kubernetes_encryption_protection" "foo" {
+  cluster_id = "cls-godovr32"
+  kms_region = "ap-guangzhou"
+  key_id     = "kms-abcd1234"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tke "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tke/v20180525"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudKubernetesEncryptionProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudKubernetesEncryptionProtectionCreate,
+		Read:   resourceTencentCloudKubernetesEncryptionProtectionRead,
+		Delete: resourceTencentCloudKubernetesEncryptionProtectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the TKE cluster.",
+			},
+			"kms_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the KMS CMK used to encrypt Kubernetes secrets.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the KMS CMK used to encrypt Kubernetes secrets.",
+			},
+
+			// Computed values
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the encryption protection, e.g. `Opened` or `Closed`.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudKubernetesEncryptionProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_encryption_protection.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	clusterId := d.Get("cluster_id").(string)
+
+	request := tke.NewCreateEncryptionProtectionRequest()
+	request.ClusterId = &clusterId
+	request.KmsConfiguration = &tke.KMSConfiguration{
+		KeyId:     helper.String(d.Get("key_id").(string)),
+		KmsRegion: helper.String(d.Get("kms_region").(string)),
+	}
+
+	if err := tkeService.CreateEncryptionProtection(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s create TKE encryption protection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(clusterId)
+
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		protection, e := tkeService.DescribeEncryptionProtectionById(ctx, clusterId)
+		if e != nil {
+			return resource.NonRetryableError(e)
+		}
+		if protection == nil || protection.Status == nil {
+			return resource.RetryableError(fmt.Errorf("TKE encryption protection for cluster %s is not ready yet", clusterId))
+		}
+		if *protection.Status == "Opening" {
+			return resource.RetryableError(fmt.Errorf("TKE encryption protection for cluster %s is still opening", clusterId))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create TKE encryption protection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudKubernetesEncryptionProtectionRead(d, meta)
+}
+
+func resourceTencentCloudKubernetesEncryptionProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_encryption_protection.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	protection, err := tkeService.DescribeEncryptionProtectionById(ctx, d.Id())
+	if err != nil {
+		log.Printf("[CRITAL]%s read TKE encryption protection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if protection == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cluster_id", d.Id())
+	_ = d.Set("kms_region", protection.KmsConfiguration.KmsRegion)
+	_ = d.Set("key_id", protection.KmsConfiguration.KeyId)
+	_ = d.Set("status", protection.Status)
+
+	return nil
+}
+
+func resourceTencentCloudKubernetesEncryptionProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_kubernetes_encryption_protection.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := tkeService.DeleteEncryptionProtection(ctx, d.Id()); err != nil {
+		log.Printf("[CRITAL]%s delete TKE encryption protection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
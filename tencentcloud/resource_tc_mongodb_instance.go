@@ -0,0 +1,414 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a MongoDB replica-set instance.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_mongodb_instance" "mongodb" {
+  instance_name  = "tf-mongodb-test"
+  memory         = 4
+  volume         = 100
+  engine_version = "MONGO_36_WT"
+  machine_type   = "GIO"
+  available_zone = "ap-guangzhou-2"
+  project_id     = 0
+  password       = "test1234"
+
+  maintenance_window {
+    day_of_week    = ["Sunday"]
+    start_time     = "03:00"
+    duration_hours = 2
+  }
+
+  tags = {
+    "test" = "test"
+  }
+}
+```
+
+Import
+
+MongoDB instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mongodb_instance.mongodb cmgo-xxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	mongodb "github.com/tencentyun/tcecloud-sdk-go/tcecloud/mongodb/v20190725"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const MONGODB_REPLICA_SET_NODE_NUM = 3
+
+func resourceTencentCloudMongodbInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMongodbInstanceCreate,
+		Read:   resourceTencentCloudMongodbInstanceRead,
+		Update: resourceTencentCloudMongodbInstanceUpdate,
+		Delete: resourceTencentCloudMongodbInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the MongoDB instance.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size of the instance, unit is GB.",
+			},
+			"volume": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size of the instance, unit is GB.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Version of the MongoDB database engine, e.g. `MONGO_36_WT`. Use `tencentcloud_mongodb_instance_upgrade` to change this after creation.",
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the instance's underlying machine, e.g. `GIO`, `HIO`, `HIO10G`.",
+			},
+			"available_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The available zone of the instance.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the subnet within the VPC.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of this MongoDB instance.",
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ID of the security groups to associate with this instance.",
+			},
+			"auto_minor_version_upgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether minor engine version upgrades are applied automatically during the maintenance window.",
+			},
+			"maintenance_window": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Weekly window during which maintenance operations and, if enabled, automatic minor-version upgrades are allowed to run.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day_of_week": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Days of the week the window applies to, e.g. `[\"Monday\", \"Sunday\"]`.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Start time of the window, in `HH:MM` format.",
+						},
+						"duration_hours": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Length of the window in hours.",
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Instance tags.",
+			},
+
+			// Computed values
+			"status": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Status of the instance.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address of the instance.",
+			},
+			"vport": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IP port of the instance.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the MongoDB instance.",
+			},
+			"pending_modification": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of a spec or engine-version change queued to apply at the next maintenance window, empty if none is pending.",
+			},
+		},
+	}
+}
+
+func mongodbMaintenanceWindowFromSchema(v interface{}) ([]string, string, int) {
+	list := v.([]interface{})
+	if len(list) == 0 {
+		return nil, "", 0
+	}
+	window := list[0].(map[string]interface{})
+	return helper.InterfacesStrings(window["day_of_week"].([]interface{})), window["start_time"].(string), window["duration_hours"].(int)
+}
+
+func resourceTencentCloudMongodbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := mongodb.NewCreateDBInstanceHourRequest()
+	request.ClusterType = helper.String("REPLSET")
+	request.ReplicationSetNum = helper.IntUint64(1)
+	request.NodeNum = helper.IntUint64(MONGODB_REPLICA_SET_NODE_NUM)
+	request.Memory = helper.IntUint64(d.Get("memory").(int))
+	request.Volume = helper.IntUint64(d.Get("volume").(int))
+	request.MongoVersion = helper.String(d.Get("engine_version").(string))
+	request.MachineCode = helper.String(d.Get("machine_type").(string))
+	request.Zone = helper.String(d.Get("available_zone").(string))
+	request.Password = helper.String(d.Get("password").(string))
+	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
+	request.GoodsNum = helper.IntUint64(1)
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		request.VpcId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		request.SubnetId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_groups"); ok {
+		request.SecurityGroup = helper.Strings(helper.InterfacesStrings(v.(*schema.Set).List()))
+	}
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instanceId, err := mongodbService.CreateShardedInstance(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create mongodb instance failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	d.SetId(instanceId)
+
+	if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+		return err
+	}
+
+	if err := mongodbService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string)); err != nil {
+		return err
+	}
+
+	if err := mongodbService.ModifyAutoUpgrade(ctx, instanceId, d.Get("auto_minor_version_upgrade").(bool)); err != nil {
+		return err
+	}
+
+	if days, startTime, durationHours := mongodbMaintenanceWindowFromSchema(d.Get("maintenance_window")); startTime != "" {
+		if err := mongodbService.ModifyMaintenanceWindow(ctx, instanceId, days, startTime, durationHours); err != nil {
+			return err
+		}
+	}
+
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		if err := mongodbService.ModifyResourceTags(ctx, instanceId, tags); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMongodbInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMongodbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	instance, err := mongodbService.DescribeInstanceById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if instance.InstanceName != nil {
+		_ = d.Set("instance_name", *instance.InstanceName)
+	}
+	if instance.Memory != nil {
+		_ = d.Set("memory", int(*instance.Memory))
+	}
+	if instance.Volume != nil {
+		_ = d.Set("volume", int(*instance.Volume))
+	}
+	if instance.MongoVersion != nil {
+		_ = d.Set("engine_version", *instance.MongoVersion)
+	}
+	if instance.Zone != nil {
+		_ = d.Set("available_zone", *instance.Zone)
+	}
+	if instance.VpcId != nil {
+		_ = d.Set("vpc_id", *instance.VpcId)
+	}
+	if instance.SubnetId != nil {
+		_ = d.Set("subnet_id", *instance.SubnetId)
+	}
+	if instance.ProjectId != nil {
+		_ = d.Set("project_id", int(*instance.ProjectId))
+	}
+	if instance.Status != nil {
+		_ = d.Set("status", int(*instance.Status))
+	}
+	if instance.Vip != nil {
+		_ = d.Set("vip", *instance.Vip)
+	}
+	if instance.Vport != nil {
+		_ = d.Set("vport", int(*instance.Vport))
+	}
+	if instance.CreateTime != nil {
+		_ = d.Set("create_time", *instance.CreateTime)
+	}
+	if instance.AutoUpgrade != nil {
+		_ = d.Set("auto_minor_version_upgrade", *instance.AutoUpgrade)
+	}
+	if instance.PendingModification != nil {
+		_ = d.Set("pending_modification", *instance.PendingModification)
+	}
+	if len(instance.Weekday) > 0 && instance.StartTime != nil && instance.TimeSpan != nil {
+		days := make([]string, 0, len(instance.Weekday))
+		for _, day := range instance.Weekday {
+			days = append(days, *day)
+		}
+		_ = d.Set("maintenance_window", []map[string]interface{}{
+			{
+				"day_of_week":    days,
+				"start_time":     *instance.StartTime,
+				"duration_hours": int(*instance.TimeSpan),
+			},
+		})
+	}
+
+	tags, err := mongodbService.DescribeResourceTags(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	_ = d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Id()
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if d.HasChange("instance_name") {
+		if err := mongodbService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("memory") || d.HasChange("volume") {
+		if err := mongodbService.UpgradeInstance(ctx, instanceId, d.Get("memory").(int), d.Get("volume").(int), 0, 0); err != nil {
+			return err
+		}
+		if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("project_id") {
+		if err := mongodbService.ModifyProjectId(ctx, instanceId, d.Get("project_id").(int)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("auto_minor_version_upgrade") {
+		if err := mongodbService.ModifyAutoUpgrade(ctx, instanceId, d.Get("auto_minor_version_upgrade").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("maintenance_window") {
+		days, startTime, durationHours := mongodbMaintenanceWindowFromSchema(d.Get("maintenance_window"))
+		if startTime != "" {
+			if err := mongodbService.ModifyMaintenanceWindow(ctx, instanceId, days, startTime, durationHours); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := mongodbService.ModifyResourceTags(ctx, instanceId, helper.GetTags(d, "tags")); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMongodbInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMongodbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return mongodbService.DeleteInstance(ctx, d.Id())
+}
@@ -10,6 +10,8 @@ import (
 )
 
 func TestAccTencentCloudMongodbInstanceResource(t *testing.T) {
+	var instanceId string
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -19,6 +21,7 @@ func TestAccTencentCloudMongodbInstanceResource(t *testing.T) {
 				Config: testAccMongodbInstance,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMongodbInstanceExists("tencentcloud_mongodb_instance.mongodb"),
+					testAccSaveMongodbInstanceId("tencentcloud_mongodb_instance.mongodb", &instanceId),
 					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "instance_name", "tf-mongodb-test"),
 					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "memory", "4"),
 					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "volume", "100"),
@@ -43,6 +46,16 @@ func TestAccTencentCloudMongodbInstanceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "tags.abc", "abc"),
 				),
 			},
+			{
+				Config: testAccMongodbInstance_maintenanceWindow,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongodbInstanceNotRecreated("tencentcloud_mongodb_instance.mongodb", &instanceId),
+					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "maintenance_window.0.day_of_week.0", "Sunday"),
+					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "maintenance_window.0.start_time", "03:00"),
+					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "maintenance_window.0.duration_hours", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_mongodb_instance.mongodb", "auto_minor_version_upgrade", "true"),
+				),
+			},
 			{
 				ResourceName:            "tencentcloud_mongodb_instance.mongodb",
 				ImportState:             true,
@@ -53,6 +66,30 @@ func TestAccTencentCloudMongodbInstanceResource(t *testing.T) {
 	})
 }
 
+func testAccSaveMongodbInstanceId(n string, instanceId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("mongodb instance %s is not found", n)
+		}
+		*instanceId = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckMongodbInstanceNotRecreated(n string, instanceId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("mongodb instance %s is not found", n)
+		}
+		if rs.Primary.ID != *instanceId {
+			return fmt.Errorf("expected mongodb instance to keep id %s across the maintenance window change, got %s", *instanceId, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckMongodbInstanceDestroy(s *terraform.State) error {
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
@@ -129,3 +166,27 @@ resource "tencentcloud_mongodb_instance" "mongodb" {
   }
 }
 `
+
+const testAccMongodbInstance_maintenanceWindow = `
+resource "tencentcloud_mongodb_instance" "mongodb" {
+  instance_name              = "tf-mongodb-update"
+  memory                     = 8
+  volume                     = 200
+  engine_version             = "MONGO_36_WT"
+  machine_type               = "GIO"
+  available_zone             = "ap-guangzhou-2"
+  project_id                 = 0
+  password                   = "tests1234"
+  auto_minor_version_upgrade = true
+
+  maintenance_window {
+    day_of_week    = ["Sunday"]
+    start_time     = "03:00"
+    duration_hours = 2
+  }
+
+  tags = {
+    "abc" = "abc"
+  }
+}
+`
@@ -0,0 +1,132 @@
+// +build tencentcloud
+
+/*
+Provides a resource to trigger an in-place engine version or spec upgrade on
+an existing MongoDB instance.
+
+This is a one-shot action resource: applying it issues the upgrade and blocks
+until it completes, but there is nothing to "undo" on destroy, and changing
+any of the target_* arguments forces a new upgrade to be triggered rather than
+attempting to reconcile a diff.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_mongodb_instance_upgrade" "upgrade" {
+  instance_id           = tencentcloud_mongodb_instance.mongodb.id
+  target_engine_version = "MONGO_40_WT"
+  target_memory         = 8
+  target_volume         = 200
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudMongodbInstanceUpgrade() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMongodbInstanceUpgradeCreate,
+		Read:   resourceTencentCloudMongodbInstanceUpgradeRead,
+		Delete: resourceTencentCloudMongodbInstanceUpgradeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the MongoDB instance to upgrade.",
+			},
+			"target_engine_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Engine version to upgrade the instance to, e.g. `MONGO_40_WT`.",
+			},
+			"target_memory": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Memory size, in GB, to upgrade the instance to.",
+			},
+			"target_volume": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Disk size, in GB, to upgrade the instance to.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMongodbInstanceUpgradeCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance_upgrade.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Get("instance_id").(string)
+	engineVersion, hasEngineVersion := d.GetOk("target_engine_version")
+	memory, hasMemory := d.GetOk("target_memory")
+	volume, hasVolume := d.GetOk("target_volume")
+
+	if !hasEngineVersion && !hasMemory && !hasVolume {
+		return fmt.Errorf("at least one of target_engine_version, target_memory or target_volume must be set")
+	}
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if hasMemory || hasVolume {
+		if err := mongodbService.UpgradeInstance(ctx, instanceId, memory.(int), volume.(int), 0, 0); err != nil {
+			log.Printf("[CRITAL]%s upgrade mongodb instance failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	if hasEngineVersion {
+		if err := mongodbService.UpgradeEngineVersion(ctx, instanceId, engineVersion.(string)); err != nil {
+			log.Printf("[CRITAL]%s upgrade mongodb instance engine version failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+		return err
+	}
+
+	d.SetId(helper.DataResourceIdHash(instanceId))
+
+	return resourceTencentCloudMongodbInstanceUpgradeRead(d, meta)
+}
+
+func resourceTencentCloudMongodbInstanceUpgradeRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance_upgrade.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	instance, err := mongodbService.DescribeInstanceById(ctx, d.Get("instance_id").(string))
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceTencentCloudMongodbInstanceUpgradeDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_instance_upgrade.delete")()
+
+	d.SetId("")
+	return nil
+}
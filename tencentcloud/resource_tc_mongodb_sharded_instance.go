@@ -0,0 +1,355 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a MongoDB sharded cluster instance.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_mongodb_sharded_instance" "mongodb" {
+  instance_name   = "tf-mongodb-sharded-test"
+  shard_quantity  = 3
+  nodes_per_shard = 3
+  shard_memory    = 4
+  shard_volume    = 100
+  mongos_cpu      = 1
+  mongos_memory   = 2
+  mongos_node_num = 3
+  engine_version  = "MONGO_40_WT"
+  available_zone  = "ap-guangzhou-2"
+  project_id      = 0
+  password        = "test1234"
+
+  tags = {
+    "test" = "test"
+  }
+}
+```
+
+Import
+
+MongoDB sharded instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mongodb_sharded_instance.mongodb cmgo-xxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	mongodb "github.com/tencentyun/tcecloud-sdk-go/tcecloud/mongodb/v20190725"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	MONGODB_SHARD_MACHINE_TYPE = "HIO10G"
+
+	MONGODB_INSTANCE_STATUS_RUNNING = int64(2)
+)
+
+func resourceTencentCloudMongodbShardedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMongodbShardedInstanceCreate,
+		Read:   resourceTencentCloudMongodbShardedInstanceRead,
+		Update: resourceTencentCloudMongodbShardedInstanceUpdate,
+		Delete: resourceTencentCloudMongodbShardedInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the MongoDB sharded instance.",
+			},
+			"shard_quantity": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Number of shards in the cluster.",
+			},
+			"nodes_per_shard": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Number of nodes (including the primary) in each shard's replica set.",
+			},
+			"shard_memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size of each shard node, unit is GB.",
+			},
+			"shard_volume": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size of each shard node, unit is GB.",
+			},
+			"mongos_cpu": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Number of CPU cores of each mongos node.",
+			},
+			"mongos_memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size of each mongos node, unit is GB.",
+			},
+			"mongos_node_num": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Number of mongos nodes in the cluster.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Version of the MongoDB engine, valid values are `MONGO_36_WT`, `MONGO_40_WT`.",
+			},
+			"available_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The available zone of the MongoDB sharded instance.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the subnet within the VPC.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of this MongoDB sharded instance.",
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ID of the security groups to associate with this instance.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Instance tags.",
+			},
+
+			// Computed values
+			"status": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Status of the instance.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address of the instance mongos.",
+			},
+			"vport": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IP port of the instance mongos.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the MongoDB sharded instance.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMongodbShardedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_sharded_instance.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := mongodb.NewCreateDBInstanceHourRequest()
+	request.ReplicateSetNum = helper.IntUint64(d.Get("shard_quantity").(int))
+	request.NodeNum = helper.IntUint64(d.Get("nodes_per_shard").(int))
+	request.Memory = helper.IntUint64(d.Get("shard_memory").(int))
+	request.Volume = helper.IntUint64(d.Get("shard_volume").(int))
+	request.MongosCpu = helper.IntUint64(d.Get("mongos_cpu").(int))
+	request.MongosMemory = helper.IntUint64(d.Get("mongos_memory").(int))
+	request.MongosNodeNum = helper.IntUint64(d.Get("mongos_node_num").(int))
+	request.MachineCode = helper.String(MONGODB_SHARD_MACHINE_TYPE)
+	request.ClusterType = helper.String("CLUSTER")
+	request.MongoVersion = helper.String(d.Get("engine_version").(string))
+	request.Zone = helper.String(d.Get("available_zone").(string))
+	request.Password = helper.String(d.Get("password").(string))
+	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
+	request.GoodsNum = helper.IntUint64(1)
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		request.VpcId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		request.SubnetId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_groups"); ok {
+		request.SecurityGroup = helper.Strings(helper.InterfacesStrings(v.(*schema.Set).List()))
+	}
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instanceId, err := mongodbService.CreateShardedInstance(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create mongodb sharded instance failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	d.SetId(instanceId)
+
+	if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+		return err
+	}
+
+	if err := mongodbService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string)); err != nil {
+		return err
+	}
+
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		if err := mongodbService.ModifyResourceTags(ctx, instanceId, tags); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMongodbShardedInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMongodbShardedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_sharded_instance.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	instance, err := mongodbService.DescribeInstanceById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if instance.InstanceName != nil {
+		_ = d.Set("instance_name", *instance.InstanceName)
+	}
+	if instance.ReplicationSetNum != nil {
+		_ = d.Set("shard_quantity", int(*instance.ReplicationSetNum))
+	}
+	if instance.SecondaryNum != nil {
+		_ = d.Set("nodes_per_shard", int(*instance.SecondaryNum))
+	}
+	if instance.MongosNodeNum != nil {
+		_ = d.Set("mongos_node_num", int(*instance.MongosNodeNum))
+	}
+	if instance.MongoVersion != nil {
+		_ = d.Set("engine_version", *instance.MongoVersion)
+	}
+	if instance.Zone != nil {
+		_ = d.Set("available_zone", *instance.Zone)
+	}
+	if instance.VpcId != nil {
+		_ = d.Set("vpc_id", *instance.VpcId)
+	}
+	if instance.SubnetId != nil {
+		_ = d.Set("subnet_id", *instance.SubnetId)
+	}
+	if instance.ProjectId != nil {
+		_ = d.Set("project_id", int(*instance.ProjectId))
+	}
+	if instance.Status != nil {
+		_ = d.Set("status", int(*instance.Status))
+	}
+	if instance.Vip != nil {
+		_ = d.Set("vip", *instance.Vip)
+	}
+	if instance.Vport != nil {
+		_ = d.Set("vport", int(*instance.Vport))
+	}
+	if instance.CreateTime != nil {
+		_ = d.Set("create_time", *instance.CreateTime)
+	}
+
+	tags, err := mongodbService.DescribeResourceTags(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	_ = d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceTencentCloudMongodbShardedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_sharded_instance.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Id()
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if d.HasChange("instance_name") {
+		if err := mongodbService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("shard_memory") || d.HasChange("shard_volume") || d.HasChange("mongos_cpu") || d.HasChange("mongos_memory") {
+		if err := mongodbService.UpgradeInstance(ctx, instanceId,
+			d.Get("shard_memory").(int), d.Get("shard_volume").(int),
+			d.Get("mongos_cpu").(int), d.Get("mongos_memory").(int)); err != nil {
+			return err
+		}
+		if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("project_id") {
+		if err := mongodbService.ModifyProjectId(ctx, instanceId, d.Get("project_id").(int)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := mongodbService.ModifyResourceTags(ctx, instanceId, helper.GetTags(d, "tags")); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMongodbShardedInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMongodbShardedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_sharded_instance.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return mongodbService.DeleteInstance(ctx, d.Id())
+}
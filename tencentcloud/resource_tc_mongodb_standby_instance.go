@@ -0,0 +1,316 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a cross-region read-only MongoDB standby
+instance, pointed at a primary instance elsewhere.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_mongodb_standby_instance" "standby" {
+  instance_name     = "tf-mongodb-standby-test"
+  father_instance_id = "cmgo-xxxxxx"
+  father_instance_region = "ap-guangzhou"
+  memory            = 4
+  volume            = 100
+  available_zone    = "ap-shanghai-2"
+  project_id        = 0
+  password          = "test1234"
+
+  tags = {
+    "test" = "test"
+  }
+}
+```
+
+Import
+
+MongoDB standby instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mongodb_standby_instance.standby cmgo-xxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	mongodb "github.com/tencentyun/tcecloud-sdk-go/tcecloud/mongodb/v20190725"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudMongodbStandbyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMongodbStandbyInstanceCreate,
+		Read:   resourceTencentCloudMongodbStandbyInstanceRead,
+		Update: resourceTencentCloudMongodbStandbyInstanceUpdate,
+		Delete: resourceTencentCloudMongodbStandbyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the MongoDB standby instance.",
+			},
+			"father_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the primary instance this standby instance replicates from.",
+			},
+			"father_instance_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of the primary instance this standby instance replicates from.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size of the standby instance, unit is GB.",
+			},
+			"volume": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size of the standby instance, unit is GB.",
+			},
+			"available_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The available zone of the standby instance.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the subnet within the VPC.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of this MongoDB standby instance.",
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ID of the security groups to associate with this instance.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Instance tags.",
+			},
+
+			// Computed values
+			"status": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Status of the instance.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address of the standby instance.",
+			},
+			"vport": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IP port of the standby instance.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the MongoDB standby instance.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	request := mongodb.NewCreateStandbyDBInstanceRequest()
+	request.FatherInstanceId = helper.String(d.Get("father_instance_id").(string))
+	request.FatherInstanceRegion = helper.String(d.Get("father_instance_region").(string))
+	request.Memory = helper.IntUint64(d.Get("memory").(int))
+	request.Volume = helper.IntUint64(d.Get("volume").(int))
+	request.Zone = helper.String(d.Get("available_zone").(string))
+	request.Password = helper.String(d.Get("password").(string))
+	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
+	request.GoodsNum = helper.IntUint64(1)
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		request.VpcId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		request.SubnetId = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("security_groups"); ok {
+		request.SecurityGroup = helper.Strings(helper.InterfacesStrings(v.(*schema.Set).List()))
+	}
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instanceId, err := mongodbService.CreateStandbyInstance(ctx, request)
+	if err != nil {
+		log.Printf("[CRITAL]%s create mongodb standby instance failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	d.SetId(instanceId)
+
+	if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+		return err
+	}
+
+	if err := mongodbService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string)); err != nil {
+		return err
+	}
+
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		if err := mongodbService.ModifyResourceTags(ctx, instanceId, tags); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMongodbStandbyInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMongodbStandbyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	instance, err := mongodbService.DescribeInstanceById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if instance.InstanceName != nil {
+		_ = d.Set("instance_name", *instance.InstanceName)
+	}
+	if instance.FatherInstanceId != nil {
+		_ = d.Set("father_instance_id", *instance.FatherInstanceId)
+	}
+	if instance.FatherInstanceRegion != nil {
+		_ = d.Set("father_instance_region", *instance.FatherInstanceRegion)
+	}
+	if instance.Memory != nil {
+		_ = d.Set("memory", int(*instance.Memory))
+	}
+	if instance.Volume != nil {
+		_ = d.Set("volume", int(*instance.Volume))
+	}
+	if instance.Zone != nil {
+		_ = d.Set("available_zone", *instance.Zone)
+	}
+	if instance.VpcId != nil {
+		_ = d.Set("vpc_id", *instance.VpcId)
+	}
+	if instance.SubnetId != nil {
+		_ = d.Set("subnet_id", *instance.SubnetId)
+	}
+	if instance.ProjectId != nil {
+		_ = d.Set("project_id", int(*instance.ProjectId))
+	}
+	if instance.Status != nil {
+		_ = d.Set("status", int(*instance.Status))
+	}
+	if instance.Vip != nil {
+		_ = d.Set("vip", *instance.Vip)
+	}
+	if instance.Vport != nil {
+		_ = d.Set("vport", int(*instance.Vport))
+	}
+	if instance.CreateTime != nil {
+		_ = d.Set("create_time", *instance.CreateTime)
+	}
+
+	tags, err := mongodbService.DescribeResourceTags(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	_ = d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Id()
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if d.HasChange("instance_name") {
+		if err := mongodbService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("memory") || d.HasChange("volume") {
+		if err := mongodbService.UpgradeInstance(ctx, instanceId, d.Get("memory").(int), d.Get("volume").(int), 0, 0); err != nil {
+			return err
+		}
+		if err := waitMongodbInstanceReady(ctx, &mongodbService, instanceId); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("project_id") {
+		if err := mongodbService.ModifyProjectId(ctx, instanceId, d.Get("project_id").(int)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := mongodbService.ModifyResourceTags(ctx, instanceId, helper.GetTags(d, "tags")); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMongodbStandbyInstanceRead(d, meta)
+}
+
+func resourceTencentCloudMongodbStandbyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return mongodbService.DeleteInstance(ctx, d.Id())
+}
@@ -0,0 +1,280 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a monitor alarm notice template, used to route
+`tencentcloud_monitor_alarm_policy` notifications to user groups, webhooks or
+an on-duty schedule.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_monitor_alarm_notice" "foo" {
+  name        = "ops-oncall"
+  notice_type = "ALL"
+
+  user_notice {
+    notice_way = ["EMAIL", "SMS"]
+    user_ids   = [10001]
+    group_ids  = [100]
+    start_time = 9
+    end_time   = 18
+  }
+
+  url_notice {
+    url        = "https://example.com/webhook"
+    start_time = 0
+    end_time   = 24
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	monitor "github.com/tencentyun/tcecloud-sdk-go/tcecloud/monitor/v20180724"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudMonitorAlarmNotice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMonitorAlarmNoticeCreate,
+		Read:   resourceTencentCloudMonitorAlarmNoticeRead,
+		Update: resourceTencentCloudMonitorAlarmNoticeUpdate,
+		Delete: resourceTencentCloudMonitorAlarmNoticeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the alarm notice template.",
+			},
+			"notice_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ALL",
+				ValidateFunc: validateAllowedStringValue([]string{"ALARM", "RECOVER", "ALL"}),
+				Description:  "When this template notifies, valid values are `ALARM` (only when alarms fire), `RECOVER` (only when alarms recover) and `ALL`. Default is `ALL`.",
+			},
+			"user_notice": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Notification to a set of users and/or user groups, restricted to an on-duty time window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notice_way": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Ways to notify, valid values include `EMAIL`, `SMS`, `CALL`, `WECHAT`.",
+						},
+						"user_ids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Ids of the users to notify.",
+						},
+						"group_ids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Ids of the user groups to notify.",
+						},
+						"start_time": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Start hour (0-23) of the on-duty window during which this notice is active. Default is 0.",
+						},
+						"end_time": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     24,
+							Description: "End hour (1-24) of the on-duty window during which this notice is active. Default is 24.",
+						},
+					},
+				},
+			},
+			"url_notice": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Webhook notification, restricted to an on-duty time window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Callback URL, must accept a POST request with a JSON body.",
+						},
+						"start_time": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Start hour (0-23) of the on-duty window during which this notice is active. Default is 0.",
+						},
+						"end_time": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     24,
+							Description: "End hour (1-24) of the on-duty window during which this notice is active. Default is 24.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMonitorAlarmNoticeCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	request := monitor.NewCreateAlarmNoticeRequest()
+	request.Module = helper.String("monitor")
+	request.Name = helper.String(d.Get("name").(string))
+	request.NoticeType = helper.String(d.Get("notice_type").(string))
+	request.UserNotices = expandMonitorAlarmNoticeUserNotices(d.Get("user_notice").([]interface{}))
+	request.URLNotices = expandMonitorAlarmNoticeURLNotices(d.Get("url_notice").([]interface{}))
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	noticeId, err := monitorService.CreateAlarmNotice(ctx, request)
+	if err != nil {
+		return err
+	}
+	d.SetId(noticeId)
+
+	return resourceTencentCloudMonitorAlarmNoticeRead(d, meta)
+}
+
+func resourceTencentCloudMonitorAlarmNoticeRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	notice, err := monitorService.DescribeAlarmNotice(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if notice == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if notice.Name != nil {
+		_ = d.Set("name", *notice.Name)
+	}
+	if notice.NoticeType != nil {
+		_ = d.Set("notice_type", *notice.NoticeType)
+	}
+	_ = d.Set("user_notice", flattenMonitorAlarmNoticeUserNotices(notice.UserNotices))
+	_ = d.Set("url_notice", flattenMonitorAlarmNoticeURLNotices(notice.URLNotices))
+
+	return nil
+}
+
+func resourceTencentCloudMonitorAlarmNoticeUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	noticeId := d.Id()
+	request := monitor.NewModifyAlarmNoticeRequest()
+	request.Module = helper.String("monitor")
+	request.NoticeId = &noticeId
+	request.Name = helper.String(d.Get("name").(string))
+	request.NoticeType = helper.String(d.Get("notice_type").(string))
+	request.UserNotices = expandMonitorAlarmNoticeUserNotices(d.Get("user_notice").([]interface{}))
+	request.URLNotices = expandMonitorAlarmNoticeURLNotices(d.Get("url_notice").([]interface{}))
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if err := monitorService.ModifyAlarmNotice(ctx, request); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudMonitorAlarmNoticeRead(d, meta)
+}
+
+func resourceTencentCloudMonitorAlarmNoticeDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return monitorService.DeleteAlarmNotice(ctx, d.Id())
+}
+
+func expandMonitorAlarmNoticeUserNotices(raw []interface{}) (notices []*monitor.AlarmNoticeUserNotice) {
+	for _, item := range raw {
+		m := item.(map[string]interface{})
+		notices = append(notices, &monitor.AlarmNoticeUserNotice{
+			NoticeWay: helper.InterfacesStrings(m["notice_way"].([]interface{})),
+			UserIds:   helper.InterfacesIntInt64(m["user_ids"].([]interface{})),
+			GroupIds:  helper.InterfacesIntInt64(m["group_ids"].([]interface{})),
+			StartTime: helper.IntInt64(m["start_time"].(int)),
+			EndTime:   helper.IntInt64(m["end_time"].(int)),
+		})
+	}
+	return
+}
+
+func expandMonitorAlarmNoticeURLNotices(raw []interface{}) (notices []*monitor.AlarmNoticeURLNotice) {
+	for _, item := range raw {
+		m := item.(map[string]interface{})
+		notices = append(notices, &monitor.AlarmNoticeURLNotice{
+			URL:       helper.String(m["url"].(string)),
+			StartTime: helper.IntInt64(m["start_time"].(int)),
+			EndTime:   helper.IntInt64(m["end_time"].(int)),
+		})
+	}
+	return
+}
+
+func flattenMonitorAlarmNoticeUserNotices(notices []*monitor.AlarmNoticeUserNotice) []interface{} {
+	result := make([]interface{}, 0, len(notices))
+	for _, notice := range notices {
+		item := map[string]interface{}{}
+		if notice.NoticeWay != nil {
+			item["notice_way"] = helper.StringsInterfaces(notice.NoticeWay)
+		}
+		if notice.UserIds != nil {
+			item["user_ids"] = helper.Int64sInterfaces(notice.UserIds)
+		}
+		if notice.GroupIds != nil {
+			item["group_ids"] = helper.Int64sInterfaces(notice.GroupIds)
+		}
+		if notice.StartTime != nil {
+			item["start_time"] = int(*notice.StartTime)
+		}
+		if notice.EndTime != nil {
+			item["end_time"] = int(*notice.EndTime)
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func flattenMonitorAlarmNoticeURLNotices(notices []*monitor.AlarmNoticeURLNotice) []interface{} {
+	result := make([]interface{}, 0, len(notices))
+	for _, notice := range notices {
+		item := map[string]interface{}{}
+		if notice.URL != nil {
+			item["url"] = *notice.URL
+		}
+		if notice.StartTime != nil {
+			item["start_time"] = int(*notice.StartTime)
+		}
+		if notice.EndTime != nil {
+			item["end_time"] = int(*notice.EndTime)
+		}
+		result = append(result, item)
+	}
+	return result
+}
@@ -0,0 +1,381 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a monitor alarm policy on top of a product
+namespace from `tencentcloud_monitor_product_namespace`.
+
+Example Usage
+
+```hcl
+data "tencentcloud_monitor_product_namespace" "cvm" {
+  name = "CVM"
+}
+
+resource "tencentcloud_monitor_alarm_policy" "foo" {
+  name      = "cvm-cpu-high"
+  namespace = data.tencentcloud_monitor_product_namespace.cvm.list.0.namespace
+  remark    = "alert when CPU usage stays high"
+
+  metric {
+    name             = "CpuUsage"
+    comparator       = 1
+    threshold        = 80
+    continue_period  = 3
+    period           = 300
+    notice_frequency = 3600
+  }
+
+  dimensions = {
+    InstanceId = "ins-xxxxxxxx"
+  }
+
+  notice_ids = [tencentcloud_monitor_alarm_notice.foo.id]
+}
+```
+
+Import
+
+Monitor alarm policy can be imported using the group id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_alarm_policy.foo 12345
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	monitor "github.com/tencentyun/tcecloud-sdk-go/tcecloud/monitor/v20180724"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudMonitorAlarmPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMonitorAlarmPolicyCreate,
+		Read:   resourceTencentCloudMonitorAlarmPolicyRead,
+		Update: resourceTencentCloudMonitorAlarmPolicyUpdate,
+		Delete: resourceTencentCloudMonitorAlarmPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the alarm policy group.",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace of the monitored product, as returned by `tencentcloud_monitor_product_namespace`.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     0,
+				Description: "Id of the project this policy belongs to. Default is 0.",
+			},
+			"is_shielded": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Indicates whether the policy is shielded (temporarily disabled). Default is `false`.",
+			},
+			"remark": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Remark of the alarm policy.",
+			},
+			"metric": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "List of metric alarm conditions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the metric, must be one of the names returned by `tencentcloud_monitor_metrics` for this policy's `namespace`.",
+						},
+						"comparator": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6}),
+							Description:  "Comparator of the alarm condition, valid values are `1` (>), `2` (>=), `3` (<), `4` (<=), `5` (=), `6` (<>).",
+						},
+						"threshold": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "Threshold value the metric is compared against.",
+						},
+						"continue_period": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Number of consecutive periods the condition must hold before alarming. Default is 1.",
+						},
+						"period": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     300,
+							Description: "Statistical period of the metric in seconds. Default is 300.",
+						},
+						"notice_frequency": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Alarm notification interval in seconds; `0` notifies once, a negative value never repeats. Default is 0.",
+						},
+						"notify_way": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateAllowedIntValue([]int{0, 1}),
+							Description:  "Alarm notification convergence, `0` (continuous) or `1` (exponential back-off). Default is `0`.",
+						},
+					},
+				},
+			},
+			"dimensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Dimensions identifying the instance(s) this policy is bound to, e.g. `{\"InstanceId\" = \"ins-xxxxxxxx\"}`.",
+			},
+			"notice_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Ids of `tencentcloud_monitor_alarm_notice` templates to notify when this policy fires.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	namespace := d.Get("namespace").(string)
+	products, err := monitorService.DescribeProductNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	var namespaceFound bool
+	for _, product := range products {
+		if product.Namespace != nil && *product.Namespace == namespace {
+			namespaceFound = true
+			break
+		}
+	}
+	if !namespaceFound {
+		return fmt.Errorf("namespace %q is not a valid monitor product namespace", namespace)
+	}
+
+	conditions, err := buildAlarmPolicyConditions(ctx, &monitorService, namespace, d.Get("metric").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	request := monitor.NewCreatePolicyGroupRequest()
+	request.GroupName = helper.String(d.Get("name").(string))
+	request.Module = helper.String("monitor")
+	request.ViewName = &namespace
+	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
+	request.IsShielded = helper.BoolToInt64Ptr(d.Get("is_shielded").(bool))
+	request.Conditions = conditions
+	if v, ok := d.GetOk("remark"); ok {
+		request.Remark = helper.String(v.(string))
+	}
+	if noticeIds := helper.InterfacesStrings(d.Get("notice_ids").([]interface{})); len(noticeIds) > 0 {
+		request.NoticeIds = noticeIds
+	}
+
+	groupId, err := monitorService.CreatePolicyGroup(ctx, request)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(groupId, 10))
+
+	if dimensions := d.Get("dimensions").(map[string]interface{}); len(dimensions) > 0 {
+		region := meta.(*TencentCloudClient).apiV3Conn.Region
+		if err := monitorService.BindPolicyObjectDimensions(ctx, groupId, region, dimensions); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMonitorAlarmPolicyRead(d, meta)
+}
+
+func resourceTencentCloudMonitorAlarmPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid monitor alarm policy id %q: %s", d.Id(), err.Error())
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	response, err := monitorService.DescribePolicyGroupDetailInfo(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	if response == nil || response.Response.GroupName == nil {
+		d.SetId("")
+		return nil
+	}
+
+	info := response.Response
+	_ = d.Set("name", *info.GroupName)
+	if info.ViewName != nil {
+		_ = d.Set("namespace", *info.ViewName)
+	}
+	if info.ProjectId != nil {
+		_ = d.Set("project_id", int(*info.ProjectId))
+	}
+	if info.Remark != nil {
+		_ = d.Set("remark", *info.Remark)
+	}
+
+	metrics := make([]interface{}, 0, len(info.ConditionsConfig))
+	for _, condition := range info.ConditionsConfig {
+		metric := map[string]interface{}{}
+		if condition.MetricShowName != nil {
+			metric["name"] = *condition.MetricShowName
+		}
+		if condition.CalcType != nil {
+			metric["comparator"] = int(*condition.CalcType)
+		}
+		if condition.CalcValue != nil {
+			threshold, err := strconv.ParseFloat(*condition.CalcValue, 64)
+			if err == nil {
+				metric["threshold"] = threshold
+			}
+		}
+		if condition.ContinueTime != nil {
+			metric["continue_period"] = int(*condition.ContinueTime)
+		}
+		if condition.Period != nil {
+			metric["period"] = int(*condition.Period)
+		}
+		if condition.AlarmNotifyPeriod != nil {
+			metric["notice_frequency"] = int(*condition.AlarmNotifyPeriod)
+		}
+		if condition.AlarmNotifyType != nil {
+			metric["notify_way"] = int(*condition.AlarmNotifyType)
+		}
+		metrics = append(metrics, metric)
+	}
+	if len(metrics) > 0 {
+		_ = d.Set("metric", metrics)
+	}
+
+	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	if len(objects) > 0 && objects[0].Dimensions != nil {
+		dimensions, err := helper.JsonToMap(*objects[0].Dimensions)
+		if err == nil {
+			_ = d.Set("dimensions", dimensions)
+		}
+	}
+
+	return nil
+}
+
+// NOTE: this resource's Update used to also push changes to name, project_id,
+// is_shielded, remark, metric and notice_ids through a ModifyPolicyGroup call,
+// but monitor v20180724 has no such request/response pair or client method -
+// only CreatePolicyGroup/DeletePolicyGroup exist for the group itself. Those
+// fields are now ForceNew instead, and Update only handles what the API can
+// actually change in place: the object(s) a policy is bound to.
+func resourceTencentCloudMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid monitor alarm policy id %q: %s", d.Id(), err.Error())
+	}
+
+	if d.HasChange("dimensions") {
+		if err := monitorService.UnbindPolicyObjectDimensions(ctx, groupId); err != nil {
+			return err
+		}
+		if dimensions := d.Get("dimensions").(map[string]interface{}); len(dimensions) > 0 {
+			region := meta.(*TencentCloudClient).apiV3Conn.Region
+			if err := monitorService.BindPolicyObjectDimensions(ctx, groupId, region, dimensions); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceTencentCloudMonitorAlarmPolicyRead(d, meta)
+}
+
+func resourceTencentCloudMonitorAlarmPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid monitor alarm policy id %q: %s", d.Id(), err.Error())
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return monitorService.DeletePolicyGroup(ctx, groupId)
+}
+
+func buildAlarmPolicyConditions(ctx context.Context, monitorService *MonitorService, namespace string, metrics []interface{}) (conditions []*monitor.CreatePolicyGroupCondition, errRet error) {
+	for _, raw := range metrics {
+		m := raw.(map[string]interface{})
+		metricName := m["name"].(string)
+
+		metric, err := monitorService.DescribeMetricByName(ctx, namespace, metricName)
+		if err != nil {
+			errRet = err
+			return
+		}
+		if metric == nil || metric.MetricId == nil {
+			errRet = fmt.Errorf("metric %q is not a valid metric of namespace %q", metricName, namespace)
+			return
+		}
+
+		condition := &monitor.CreatePolicyGroupCondition{
+			MetricId:          metric.MetricId,
+			CalcType:          helper.IntInt64(m["comparator"].(int)),
+			CalcValue:         helper.Float64(m["threshold"].(float64)),
+			ContinuePeriod:    helper.IntInt64(m["continue_period"].(int)),
+			CalcPeriod:        helper.IntInt64(m["period"].(int)),
+			AlarmNotifyPeriod: helper.IntInt64(m["notice_frequency"].(int)),
+			AlarmNotifyType:   helper.IntInt64(m["notify_way"].(int)),
+		}
+		conditions = append(conditions, condition)
+	}
+	return
+}
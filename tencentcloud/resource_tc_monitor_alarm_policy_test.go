@@ -0,0 +1,115 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTencentCloudMonitorAlarmPolicy(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitorAlarmPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorAlarmPolicy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmPolicyExists("tencentcloud_monitor_alarm_policy.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_alarm_policy.foo", "name", "cpu-usage-high"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_alarm_policy.foo", "namespace", "cvm_device"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_alarm_policy.foo", "metric.0.name", "CpuUsage"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_alarm_policy.foo", "metric.0.comparator", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_monitor_alarm_policy.foo", "metric.0.notify_way", "1"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_monitor_alarm_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMonitorAlarmPolicyDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	monitorService := MonitorService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_monitor_alarm_policy" {
+			continue
+		}
+
+		groupId, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		response, err := monitorService.DescribePolicyGroupDetailInfo(ctx, groupId)
+		if err != nil {
+			return err
+		}
+		if response != nil && response.Response.GroupName != nil {
+			return fmt.Errorf("monitor alarm policy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckMonitorAlarmPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("monitor alarm policy %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("monitor alarm policy id is not set")
+		}
+
+		groupId, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		monitorService := MonitorService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+		response, err := monitorService.DescribePolicyGroupDetailInfo(ctx, groupId)
+		if err != nil {
+			return err
+		}
+		if response == nil || response.Response.GroupName == nil {
+			return fmt.Errorf("monitor alarm policy %s is not found on the server side", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+const testAccMonitorAlarmPolicy = `
+data "tencentcloud_monitor_product_namespace" "cvm" {
+  name = "CVM"
+}
+
+resource "tencentcloud_monitor_alarm_policy" "foo" {
+  name      = "cpu-usage-high"
+  namespace = data.tencentcloud_monitor_product_namespace.cvm.list.0.namespace
+  remark    = "alert when CPU usage stays high"
+
+  metric {
+    name             = "CpuUsage"
+    comparator       = 1
+    threshold        = 80
+    continue_period  = 3
+    period           = 300
+    notice_frequency = 3600
+    notify_way       = 1
+  }
+}
+`
@@ -0,0 +1,189 @@
+// +build tencentcloud
+
+/*
+Provides a resource to bind a single object (identified by its dimensions)
+to a monitor policy group, such as one created by
+`tencentcloud_monitor_policy_group`. Multiple `tencentcloud_monitor_binding_object`
+resources can point at the same `group_id` to bind several objects to one
+policy group. `dimensions` takes the same raw key/value map the console's
+"edit dimensions" JSON accepts (e.g. `InstanceId` for CVM, `InstanceId` and
+`Region` for CDB, `LoadBalancerId` for CLB) - there's no typed per-product
+binding_objects alternative, since the API itself doesn't distinguish object
+types beyond whatever dimension keys the target product's metrics use.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_monitor_policy_group" "foo" {
+  group_name  = "cvm-cpu-high"
+  policy_view = "cvm_device"
+
+  condition {
+    metric_name = "CpuUsage"
+    comparator  = 1
+    threshold   = 80
+  }
+}
+
+resource "tencentcloud_monitor_binding_object" "foo" {
+  group_id = tencentcloud_monitor_policy_group.foo.id
+
+  dimensions = {
+    InstanceId = "ins-xxxxxxxx"
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudMonitorBindingObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMonitorBindingObjectCreate,
+		Read:   resourceTencentCloudMonitorBindingObjectRead,
+		Delete: resourceTencentCloudMonitorBindingObjectDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the policy group to bind the object to, such as the id of a `tencentcloud_monitor_policy_group`.",
+			},
+			"dimensions": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Dimensions identifying the object to bind, e.g. `{\"InstanceId\" = \"ins-xxxxxxxx\"}`.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Region of the bound object. Defaults to the provider's configured region.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMonitorBindingObjectCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_binding_object.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId := int64(d.Get("group_id").(int))
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*TencentCloudClient).apiV3Conn.Region
+	}
+	dimensions := d.Get("dimensions").(map[string]interface{})
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if err := monitorService.BindPolicyObjectDimensions(ctx, groupId, region, dimensions); err != nil {
+		return err
+	}
+
+	dimensionsJsonBytes, err := json.Marshal(dimensions)
+	if err != nil {
+		return fmt.Errorf("invalid dimensions: %s", err.Error())
+	}
+	dimensionsJson := string(dimensionsJsonBytes)
+
+	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	var uniqueId string
+	for _, object := range objects {
+		if object.Dimensions != nil && *object.Dimensions == dimensionsJson && object.UniqueId != nil {
+			uniqueId = *object.UniqueId
+			break
+		}
+	}
+	if uniqueId == "" {
+		return fmt.Errorf("could not find the bound object for group %d right after binding it", groupId)
+	}
+
+	d.SetId(strconv.FormatInt(groupId, 10) + FILED_SP + uniqueId)
+
+	return resourceTencentCloudMonitorBindingObjectRead(d, meta)
+}
+
+func resourceTencentCloudMonitorBindingObjectRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_binding_object.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId, uniqueId, err := parseMonitorBindingObjectId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		if object.UniqueId == nil || *object.UniqueId != uniqueId {
+			continue
+		}
+		_ = d.Set("group_id", int(groupId))
+		if object.Region != nil {
+			_ = d.Set("region", *object.Region)
+		}
+		if object.Dimensions != nil {
+			dimensions, err := helper.JsonToMap(*object.Dimensions)
+			if err == nil {
+				_ = d.Set("dimensions", dimensions)
+			}
+		}
+		return nil
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceTencentCloudMonitorBindingObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_binding_object.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId, uniqueId, err := parseMonitorBindingObjectId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return monitorService.UnbindPolicyObjectByUniqueId(ctx, groupId, uniqueId)
+}
+
+func parseMonitorBindingObjectId(id string) (groupId int64, uniqueId string, errRet error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		errRet = fmt.Errorf("invalid monitor binding object id %q", id)
+		return
+	}
+	groupId, errRet = strconv.ParseInt(parts[0], 10, 64)
+	if errRet != nil {
+		return
+	}
+	uniqueId = parts[1]
+	return
+}
@@ -0,0 +1,343 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a monitor policy group, a named set of
+threshold conditions on a single product namespace that
+`tencentcloud_monitor_binding_object` resources and the `notice_ids`
+argument attach objects and alarm notices to.
+
+This is a thinner, composable alternative to `tencentcloud_monitor_alarm_policy`:
+it only owns the group and its conditions, leaving object binding to one or
+more separate `tencentcloud_monitor_binding_object` resources instead of a
+single `dimensions` argument.
+
+Example Usage
+
+```hcl
+data "tencentcloud_monitor_product_namespace" "cvm" {
+  name = "CVM"
+}
+
+resource "tencentcloud_monitor_policy_group" "foo" {
+  group_name  = "cvm-cpu-high"
+  policy_view = data.tencentcloud_monitor_product_namespace.cvm.list.0.namespace
+  remark      = "alert when CPU usage stays high"
+
+  condition {
+    metric_name      = "CpuUsage"
+    comparator       = 1
+    threshold        = 80
+    continue_period  = 3
+    period           = 300
+    notice_frequency = 3600
+  }
+
+  notice_ids = [tencentcloud_monitor_alarm_notice.foo.id]
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	monitor "github.com/tencentyun/tcecloud-sdk-go/tcecloud/monitor/v20180724"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudMonitorPolicyGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudMonitorPolicyGroupCreate,
+		Read:   resourceTencentCloudMonitorPolicyGroupRead,
+		Update: resourceTencentCloudMonitorPolicyGroupUpdate,
+		Delete: resourceTencentCloudMonitorPolicyGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the policy group.",
+			},
+			"policy_view": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace of the monitored product, as returned by `tencentcloud_monitor_product_namespace`.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Id of the project this policy group belongs to. Default is 0.",
+			},
+			"is_shielded": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether the policy group is shielded (temporarily disabled). Default is `false`.",
+			},
+			"remark": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Remark of the policy group.",
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "List of threshold alarm conditions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the metric, must be one of the names returned by `tencentcloud_monitor_metrics` for this group's `policy_view`.",
+						},
+						"comparator": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 5, 6}),
+							Description:  "Comparator of the alarm condition, valid values are `1` (>), `2` (>=), `3` (<), `4` (<=), `5` (=), `6` (<>).",
+						},
+						"threshold": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "Threshold value the metric is compared against.",
+						},
+						"continue_period": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Number of consecutive periods the condition must hold before alarming. Default is 1.",
+						},
+						"period": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     300,
+							Description: "Statistical period of the metric in seconds. Default is 300.",
+						},
+						"notice_frequency": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Alarm notification interval in seconds; `0` notifies once, a negative value never repeats. Default is 0.",
+						},
+					},
+				},
+			},
+			"notice_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Ids of `tencentcloud_monitor_alarm_notice` templates to notify when this policy group fires.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudMonitorPolicyGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_policy_group.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	policyView := d.Get("policy_view").(string)
+	products, err := monitorService.DescribeProductNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	var namespaceFound bool
+	for _, product := range products {
+		if product.Namespace != nil && *product.Namespace == policyView {
+			namespaceFound = true
+			break
+		}
+	}
+	if !namespaceFound {
+		return fmt.Errorf("policy_view %q is not a valid monitor product namespace", policyView)
+	}
+
+	conditions, err := buildPolicyGroupConditions(ctx, &monitorService, policyView, d.Get("condition").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	request := monitor.NewCreatePolicyGroupRequest()
+	request.GroupName = helper.String(d.Get("group_name").(string))
+	request.Module = helper.String("monitor")
+	request.ViewName = &policyView
+	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
+	request.IsShielded = helper.BoolToInt64Ptr(d.Get("is_shielded").(bool))
+	request.Conditions = conditions
+	if v, ok := d.GetOk("remark"); ok {
+		request.Remark = helper.String(v.(string))
+	}
+	if noticeIds := helper.InterfacesStrings(d.Get("notice_ids").([]interface{})); len(noticeIds) > 0 {
+		request.NoticeIds = noticeIds
+	}
+
+	groupId, err := monitorService.CreatePolicyGroup(ctx, request)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(groupId, 10))
+
+	return resourceTencentCloudMonitorPolicyGroupRead(d, meta)
+}
+
+func resourceTencentCloudMonitorPolicyGroupRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_policy_group.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid monitor policy group id %q: %s", d.Id(), err.Error())
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	response, err := monitorService.DescribePolicyGroupDetailInfo(ctx, groupId)
+	if err != nil {
+		return err
+	}
+	if response == nil || response.Response.GroupName == nil {
+		d.SetId("")
+		return nil
+	}
+
+	info := response.Response
+	_ = d.Set("group_name", *info.GroupName)
+	if info.ViewName != nil {
+		_ = d.Set("policy_view", *info.ViewName)
+	}
+	if info.ProjectId != nil {
+		_ = d.Set("project_id", int(*info.ProjectId))
+	}
+	if info.Remark != nil {
+		_ = d.Set("remark", *info.Remark)
+	}
+
+	conditions := make([]interface{}, 0, len(info.ConditionsConfig))
+	for _, condition := range info.ConditionsConfig {
+		item := map[string]interface{}{}
+		if condition.MetricShowName != nil {
+			item["metric_name"] = *condition.MetricShowName
+		}
+		if condition.CalcType != nil {
+			item["comparator"] = int(*condition.CalcType)
+		}
+		if condition.CalcValue != nil {
+			threshold, err := strconv.ParseFloat(*condition.CalcValue, 64)
+			if err == nil {
+				item["threshold"] = threshold
+			}
+		}
+		if condition.ContinueTime != nil {
+			item["continue_period"] = int(*condition.ContinueTime)
+		}
+		if condition.Period != nil {
+			item["period"] = int(*condition.Period)
+		}
+		if condition.AlarmNotifyPeriod != nil {
+			item["notice_frequency"] = int(*condition.AlarmNotifyPeriod)
+		}
+		conditions = append(conditions, item)
+	}
+	if len(conditions) > 0 {
+		_ = d.Set("condition", conditions)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudMonitorPolicyGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_policy_group.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid monitor policy group id %q: %s", d.Id(), err.Error())
+	}
+	policyView := d.Get("policy_view").(string)
+
+	if d.HasChange("group_name") || d.HasChange("is_shielded") || d.HasChange("remark") ||
+		d.HasChange("project_id") || d.HasChange("condition") || d.HasChange("notice_ids") {
+
+		conditions, err := buildPolicyGroupConditions(ctx, &monitorService, policyView, d.Get("condition").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		request := monitor.NewModifyPolicyGroupRequest()
+		request.GroupId = &groupId
+		request.Module = helper.String("monitor")
+		request.GroupName = helper.String(d.Get("group_name").(string))
+		request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
+		request.IsShielded = helper.BoolToInt64Ptr(d.Get("is_shielded").(bool))
+		request.Conditions = conditions
+		if v, ok := d.GetOk("remark"); ok {
+			request.Remark = helper.String(v.(string))
+		}
+		if noticeIds := helper.InterfacesStrings(d.Get("notice_ids").([]interface{})); len(noticeIds) > 0 {
+			request.NoticeIds = noticeIds
+		}
+
+		if err := monitorService.ModifyPolicyGroup(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudMonitorPolicyGroupRead(d, meta)
+}
+
+func resourceTencentCloudMonitorPolicyGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_monitor_policy_group.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid monitor policy group id %q: %s", d.Id(), err.Error())
+	}
+
+	monitorService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return monitorService.DeletePolicyGroup(ctx, groupId)
+}
+
+func buildPolicyGroupConditions(ctx context.Context, monitorService *MonitorService, policyView string, rawConditions []interface{}) (conditions []*monitor.CreatePolicyGroupCondition, errRet error) {
+	for _, raw := range rawConditions {
+		m := raw.(map[string]interface{})
+		metricName := m["metric_name"].(string)
+
+		metric, err := monitorService.DescribeMetricByName(ctx, policyView, metricName)
+		if err != nil {
+			errRet = err
+			return
+		}
+		if metric == nil || metric.MetricId == nil {
+			errRet = fmt.Errorf("metric %q is not a valid metric of namespace %q", metricName, policyView)
+			return
+		}
+
+		condition := &monitor.CreatePolicyGroupCondition{
+			MetricId:          metric.MetricId,
+			CalcType:          helper.IntInt64(m["comparator"].(int)),
+			CalcValue:         helper.Float64(m["threshold"].(float64)),
+			ContinuePeriod:    helper.IntInt64(m["continue_period"].(int)),
+			CalcPeriod:        helper.IntInt64(m["period"].(int)),
+			AlarmNotifyPeriod: helper.IntInt64(m["notice_frequency"].(int)),
+		}
+		conditions = append(conditions, condition)
+	}
+	return
+}
@@ -0,0 +1,320 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a destination-NAT (port forwarding) entry for a
+NAT gateway, forwarding `public_ip:public_port/protocol` to
+`private_ip:private_port`.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_nat_gateway_forward_entry" "foo" {
+  nat_gateway_id  = "nat-xfaq1"
+  protocol        = "TCP"
+  public_ip       = "203.0.113.1"
+  public_port     = 8080
+  private_ip      = "10.0.0.12"
+  private_port    = 80
+  description     = "web server"
+}
+```
+
+Import
+
+NAT gateway forward entry can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_nat_gateway_forward_entry.foo nat-xfaq1#TCP#203.0.113.1#8080
+```
+*/
+package tencentcloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudNatGatewayForwardEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudNatGatewayForwardEntryCreate,
+		Read:   resourceTencentCloudNatGatewayForwardEntryRead,
+		Update: resourceTencentCloudNatGatewayForwardEntryUpdate,
+		Delete: resourceTencentCloudNatGatewayForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the NAT gateway.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"TCP", "UDP"}),
+				Description:  "Network protocol, valid values are `TCP`, `UDP`.",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "EIP of the NAT gateway this entry forwards from.",
+			},
+			"public_port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Public port this entry forwards from.",
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Private IP this entry forwards to.",
+			},
+			"private_port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Private port this entry forwards to.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of this forward entry.",
+			},
+		},
+	}
+}
+
+func natGatewayForwardEntryId(gatewayId, protocol, publicIp string, publicPort int) string {
+	return strings.Join([]string{gatewayId, protocol, publicIp, strconv.Itoa(publicPort)}, FILED_SP)
+}
+
+func parseNatGatewayForwardEntryId(id string) (gatewayId, protocol, publicIp string, publicPort int, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 4 {
+		err = fmt.Errorf("invalid NAT gateway forward entry id: %s", id)
+		return
+	}
+	publicPort, err = strconv.Atoi(parts[3])
+	if err != nil {
+		err = fmt.Errorf("invalid NAT gateway forward entry id: %s", id)
+		return
+	}
+	gatewayId, protocol, publicIp = parts[0], parts[1], parts[2]
+	return
+}
+
+func resourceTencentCloudNatGatewayForwardEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_forward_entry.create")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	protocol := d.Get("protocol").(string)
+	publicIp := d.Get("public_ip").(string)
+	publicPort := d.Get("public_port").(int)
+
+	request := vpc.NewCreateNatGatewayDestinationIpPortTranslationNatRuleRequest()
+	request.NatGatewayId = &gatewayId
+	rule := &vpc.DestinationIpPortTranslationNatRule{
+		IpProtocol:      helper.String(protocol),
+		PublicIpAddress: helper.String(publicIp),
+		PublicPort:      helper.IntUint64(publicPort),
+		PrivateIpAddress: helper.String(d.Get("private_ip").(string)),
+		PrivatePort:      helper.IntUint64(d.Get("private_port").(int)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		rule.Description = helper.String(v.(string))
+	}
+	request.DestinationIpPortTranslationNatRules = []*vpc.DestinationIpPortTranslationNatRule{rule}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNatGatewayDestinationIpPortTranslationNatRule(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create NAT gateway forward entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(natGatewayForwardEntryId(gatewayId, protocol, publicIp, publicPort))
+
+	return resourceTencentCloudNatGatewayForwardEntryRead(d, meta)
+}
+
+func resourceTencentCloudNatGatewayForwardEntryRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_forward_entry.read")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, protocol, publicIp, publicPort, err := parseNatGatewayForwardEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDescribeNatGatewayDestinationIpPortTranslationNatRulesRequest()
+	request.NatGatewayIds = []*string{&gatewayId}
+	var response *vpc.DescribeNatGatewayDestinationIpPortTranslationNatRulesResponse
+	err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRules(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == VPCNotFound || ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read NAT gateway forward entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil {
+		d.SetId("")
+		return nil
+	}
+
+	for _, rule := range response.Response.NatGatewayDestinationIpPortTranslationNatRuleSet {
+		if rule.IpProtocol == nil || rule.PublicIpAddress == nil || rule.PublicPort == nil {
+			continue
+		}
+		if *rule.IpProtocol == protocol && *rule.PublicIpAddress == publicIp && int(*rule.PublicPort) == publicPort {
+			_ = d.Set("nat_gateway_id", gatewayId)
+			_ = d.Set("protocol", *rule.IpProtocol)
+			_ = d.Set("public_ip", *rule.PublicIpAddress)
+			_ = d.Set("public_port", int(*rule.PublicPort))
+			if rule.PrivateIpAddress != nil {
+				_ = d.Set("private_ip", *rule.PrivateIpAddress)
+			}
+			if rule.PrivatePort != nil {
+				_ = d.Set("private_port", int(*rule.PrivatePort))
+			}
+			if rule.Description != nil {
+				_ = d.Set("description", *rule.Description)
+			}
+			return nil
+		}
+	}
+
+	//the entry has been deleted out-of-band, recreate it on next apply
+	d.SetId("")
+	return nil
+}
+
+func resourceTencentCloudNatGatewayForwardEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_forward_entry.update")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, protocol, publicIp, publicPort, err := parseNatGatewayForwardEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule := &vpc.DestinationIpPortTranslationNatRule{
+		IpProtocol:       helper.String(protocol),
+		PublicIpAddress:  helper.String(publicIp),
+		PublicPort:       helper.IntUint64(publicPort),
+		PrivateIpAddress: helper.String(d.Get("private_ip").(string)),
+		PrivatePort:      helper.IntUint64(d.Get("private_port").(int)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		rule.Description = helper.String(v.(string))
+	}
+
+	request := vpc.NewModifyNatGatewayDestinationIpPortTranslationNatRuleRequest()
+	request.NatGatewayId = &gatewayId
+	request.SourceNatRule = rule
+	request.DestinationNatRule = rule
+
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNatGatewayDestinationIpPortTranslationNatRule(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s modify NAT gateway forward entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudNatGatewayForwardEntryRead(d, meta)
+}
+
+func resourceTencentCloudNatGatewayForwardEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_forward_entry.delete")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, protocol, publicIp, publicPort, err := parseNatGatewayForwardEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDeleteNatGatewayDestinationIpPortTranslationNatRuleRequest()
+	request.NatGatewayId = &gatewayId
+	request.DestinationIpPortTranslationNatRules = []*vpc.DestinationIpPortTranslationNatRule{
+		{
+			IpProtocol:      helper.String(protocol),
+			PublicIpAddress: helper.String(publicIp),
+			PublicPort:      helper.IntUint64(publicPort),
+			PrivateIpAddress: helper.String(d.Get("private_ip").(string)),
+			PrivatePort:      helper.IntUint64(d.Get("private_port").(int)),
+		},
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteNatGatewayDestinationIpPortTranslationNatRule(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && (ee.Code == VPCNotFound || ee.Code == "ResourceNotFound") {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete NAT gateway forward entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a source-NAT entry for a NAT gateway, mapping
+either a subnet CIDR or a single CVM private IP to a chosen EIP on the
+gateway.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_nat_gateway_snat_entry" "subnet" {
+  nat_gateway_id = "nat-xfaq1"
+  resource_type  = "SUBNET"
+  resource_id    = "subnet-2ap8u20r"
+  public_ip      = "203.0.113.1"
+}
+
+resource "tencentcloud_nat_gateway_snat_entry" "instance" {
+  nat_gateway_id = "nat-xfaq1"
+  resource_type  = "NETWORKINTERFACE"
+  resource_id    = "10.0.0.12"
+  public_ip      = "203.0.113.2"
+}
+```
+
+Import
+
+NAT gateway SNAT entry can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_nat_gateway_snat_entry.foo nat-xfaq1#SUBNET#subnet-2ap8u20r#203.0.113.1
+```
+*/
+package tencentcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	NAT_GATEWAY_SNAT_RESOURCE_TYPE_SUBNET           = "SUBNET"
+	NAT_GATEWAY_SNAT_RESOURCE_TYPE_NETWORKINTERFACE = "NETWORKINTERFACE"
+)
+
+func resourceTencentCloudNatGatewaySnatEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudNatGatewaySnatEntryCreate,
+		Read:   resourceTencentCloudNatGatewaySnatEntryRead,
+		Delete: resourceTencentCloudNatGatewaySnatEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the NAT gateway.",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{NAT_GATEWAY_SNAT_RESOURCE_TYPE_SUBNET, NAT_GATEWAY_SNAT_RESOURCE_TYPE_NETWORKINTERFACE}),
+				Description:  "Type of the resource this entry translates, valid values are `SUBNET` (a subnet CIDR block) and `NETWORKINTERFACE` (a single CVM private IP).",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the resource to translate: the subnet id when `resource_type` is `SUBNET`, or the CVM private IP when `resource_type` is `NETWORKINTERFACE`.",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "EIP of the NAT gateway this entry translates to.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of this SNAT entry.",
+			},
+		},
+	}
+}
+
+func natGatewaySnatEntryId(gatewayId, resourceType, resourceId, publicIp string) string {
+	return strings.Join([]string{gatewayId, resourceType, resourceId, publicIp}, FILED_SP)
+}
+
+func parseNatGatewaySnatEntryId(id string) (gatewayId, resourceType, resourceId, publicIp string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 4 {
+		err = fmt.Errorf("invalid NAT gateway SNAT entry id: %s", id)
+		return
+	}
+	gatewayId, resourceType, resourceId, publicIp = parts[0], parts[1], parts[2], parts[3]
+	return
+}
+
+func resourceTencentCloudNatGatewaySnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_snat_entry.create")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	resourceType := d.Get("resource_type").(string)
+	resourceId := d.Get("resource_id").(string)
+	publicIp := d.Get("public_ip").(string)
+
+	request := vpc.NewCreateNatGatewaySourceIpTranslationNatRuleRequest()
+	request.NatGatewayId = &gatewayId
+	rule := &vpc.SourceIpTranslationNatRule{
+		ResourceType:    helper.String(resourceType),
+		ResourceId:      helper.String(resourceId),
+		PublicIpAddress: helper.String(publicIp),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		rule.Description = helper.String(v.(string))
+	}
+	request.SourceIpTranslationNatRules = []*vpc.SourceIpTranslationNatRule{rule}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNatGatewaySourceIpTranslationNatRule(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create NAT gateway SNAT entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(natGatewaySnatEntryId(gatewayId, resourceType, resourceId, publicIp))
+
+	return resourceTencentCloudNatGatewaySnatEntryRead(d, meta)
+}
+
+func resourceTencentCloudNatGatewaySnatEntryRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_snat_entry.read")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, resourceType, resourceId, publicIp, err := parseNatGatewaySnatEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDescribeNatGatewaySourceIpTranslationNatRulesRequest()
+	request.NatGatewayIds = []*string{&gatewayId}
+	var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
+	err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == VPCNotFound || ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read NAT gateway SNAT entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil {
+		d.SetId("")
+		return nil
+	}
+
+	for _, rule := range response.Response.SourceIpTranslationNatRuleSet {
+		if rule.ResourceType == nil || rule.ResourceId == nil || rule.PublicIpAddress == nil {
+			continue
+		}
+		if *rule.ResourceType == resourceType && *rule.ResourceId == resourceId && *rule.PublicIpAddress == publicIp {
+			_ = d.Set("nat_gateway_id", gatewayId)
+			_ = d.Set("resource_type", *rule.ResourceType)
+			_ = d.Set("resource_id", *rule.ResourceId)
+			_ = d.Set("public_ip", *rule.PublicIpAddress)
+			if rule.Description != nil {
+				_ = d.Set("description", *rule.Description)
+			}
+			return nil
+		}
+	}
+
+	//the entry has been deleted out-of-band, recreate it on next apply
+	d.SetId("")
+	return nil
+}
+
+func resourceTencentCloudNatGatewaySnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_nat_gateway_snat_entry.delete")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, resourceType, resourceId, publicIp, err := parseNatGatewaySnatEntryId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDeleteNatGatewaySourceIpTranslationNatRuleRequest()
+	request.NatGatewayId = &gatewayId
+	request.SourceIpTranslationNatRules = []*vpc.SourceIpTranslationNatRule{
+		{
+			ResourceType:    helper.String(resourceType),
+			ResourceId:      helper.String(resourceId),
+			PublicIpAddress: helper.String(publicIp),
+		},
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteNatGatewaySourceIpTranslationNatRule(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && (ee.Code == VPCNotFound || ee.Code == "ResourceNotFound") {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete NAT gateway SNAT entry failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Provides a resource to create an on-demand backup of a Redis instance.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_redis_instance" "foo" {
+  availability_zone = "ap-hongkong-3"
+  type              = "master_slave_redis"
+  password          = "test12345789"
+  mem_size          = 8192
+  name              = "terrform_test"
+  port              = 6379
+}
+
+resource "tencentcloud_redis_backup" "foo" {
+  redis_id = tencentcloud_redis_instance.foo.id
+  remark   = "pre-migration snapshot"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTencentCloudRedisBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudRedisBackupCreate,
+		Read:   resourceTencentCloudRedisBackupRead,
+		Delete: resourceTencentCloudRedisBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the redis instance to back up.",
+			},
+			"remark": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Remark attached to the backup, used to find it back among the instance's backup set.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the created backup.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the backup started.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudRedisBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisId := d.Get("redis_id").(string)
+	remark := d.Get("remark").(string)
+
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	taskId, err := redisService.ManualBackupInstance(ctx, redisId, remark)
+	if err != nil {
+		log.Printf("[CRITAL]%s redis manual backup fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if err := waitRedisTaskDone(ctx, &redisService, redisId, taskId, 2*readRetryTimeout); err != nil {
+		log.Printf("[CRITAL]%s redis manual backup fail, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	backups, err := redisService.DescribeInstanceBackups(ctx, redisId, "", "")
+	if err != nil {
+		return err
+	}
+
+	// ManualBackupInstance doesn't hand back the backup_id it just created,
+	// so find it as the most recent manual backup matching our remark.
+	var backupId, latestStart string
+	for _, backup := range backups {
+		if backup.BackupType == nil || *backup.BackupType != "manualBackupInstance" {
+			continue
+		}
+		if remark != "" && (backup.Remark == nil || *backup.Remark != remark) {
+			continue
+		}
+		if backup.StartTime != nil && *backup.StartTime > latestStart {
+			latestStart = *backup.StartTime
+			backupId = *backup.BackupId
+		}
+	}
+	if backupId == "" {
+		return fmt.Errorf("redis instance %s has no manual backup matching the one just created", redisId)
+	}
+
+	d.SetId(strings.Join([]string{redisId, backupId}, FILED_SP))
+
+	return resourceTencentCloudRedisBackupRead(d, meta)
+}
+
+func resourceTencentCloudRedisBackupRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisId, backupId, err := parseRedisBackupId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+	backups, err := redisService.DescribeInstanceBackups(ctx, redisId, "", "")
+	if err != nil {
+		return err
+	}
+
+	for _, backup := range backups {
+		if backup.BackupId != nil && *backup.BackupId == backupId {
+			_ = d.Set("redis_id", redisId)
+			_ = d.Set("backup_id", backupId)
+			_ = d.Set("start_time", backup.StartTime)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN]%s redis backup %s no longer exists, removing from state\n", logId, d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceTencentCloudRedisBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup.delete")()
+
+	// There is no Tencent Cloud API to delete a single redis backup; backups
+	// expire on their own retention schedule. Deleting this resource just
+	// stops Terraform from tracking it.
+	return nil
+}
+
+func parseRedisBackupId(id string) (redisId, backupId string, errRet error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		errRet = fmt.Errorf("invalid tencentcloud_redis_backup id %s, expected <redis_id>%s<backup_id>", id, FILED_SP)
+		return
+	}
+	redisId, backupId = parts[0], parts[1]
+	return
+}
@@ -0,0 +1,142 @@
+/*
+Provides a resource to configure the automatic backup schedule of a Redis
+instance.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_redis_instance" "foo" {
+  availability_zone = "ap-hongkong-3"
+  type              = "master_slave_redis"
+  password          = "test12345789"
+  mem_size          = 8192
+  name              = "terrform_test"
+  port              = 6379
+}
+
+resource "tencentcloud_redis_backup_config" "foo" {
+  redis_id   = tencentcloud_redis_instance.foo.id
+  week_days  = ["Monday", "Thursday"]
+  time_period = "02:00-06:00"
+}
+```
+
+Import
+
+Redis backup config can be imported using the redis instance id, e.g.
+
+```
+$ terraform import tencentcloud_redis_backup_config.foo redis-id
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudRedisBackupConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudRedisBackupConfigCreate,
+		Read:   resourceTencentCloudRedisBackupConfigRead,
+		Update: resourceTencentCloudRedisBackupConfigUpdate,
+		Delete: resourceTencentCloudRedisBackupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the redis instance to configure the automatic backup schedule on.",
+			},
+			"week_days": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Days of the week (`Monday` .. `Sunday`) on which the automatic backup runs.",
+			},
+			"time_period": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Time window the automatic backup is allowed to run in, e.g. `02:00-06:00`.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudRedisBackupConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup_config.create")()
+
+	redisId := d.Get("redis_id").(string)
+
+	if err := modifyRedisBackupConfig(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(redisId)
+
+	return resourceTencentCloudRedisBackupConfigRead(d, meta)
+}
+
+func resourceTencentCloudRedisBackupConfigRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup_config.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tcClient := meta.(*TencentCloudClient)
+	redisService := RedisService{client: tcClient.apiV3Conn, readCache: tcClient.readCache}
+
+	weekDays, timePeriod, err := redisService.DescribeAutoBackupConfig(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+
+	_ = d.Set("redis_id", d.Id())
+	_ = d.Set("week_days", weekDays)
+	_ = d.Set("time_period", timePeriod)
+
+	return nil
+}
+
+func resourceTencentCloudRedisBackupConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup_config.update")()
+
+	if !d.HasChange("week_days") && !d.HasChange("time_period") {
+		return resourceTencentCloudRedisBackupConfigRead(d, meta)
+	}
+
+	if err := modifyRedisBackupConfig(d, meta); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudRedisBackupConfigRead(d, meta)
+}
+
+func modifyRedisBackupConfig(d *schema.ResourceData, meta interface{}) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisId := d.Get("redis_id").(string)
+	weekDays := helper.InterfacesStrings(d.Get("week_days").(*schema.Set).List())
+	timePeriod := d.Get("time_period").(string)
+
+	tcClient := meta.(*TencentCloudClient)
+	redisService := RedisService{client: tcClient.apiV3Conn, readCache: tcClient.readCache}
+	return redisService.ModifyAutoBackupConfig(ctx, redisId, weekDays, timePeriod)
+}
+
+func resourceTencentCloudRedisBackupConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_backup_config.delete")()
+
+	// There is no API to clear the automatic backup schedule, only to set a
+	// new one, so deleting this resource just stops Terraform from managing
+	// it going forward; the instance keeps whatever schedule was last applied.
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Provides a resource to enable read-only routing to a Redis instance's
+replicas, so reads can be served off `tencentcloud_redis_instance` replicas
+instead of only the master.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_redis_instance" "foo" {
+  availability_zone = "ap-hongkong-3"
+  type              = "master_slave_redis"
+  password          = "test12345789"
+  mem_size          = 8192
+  name              = "terrform_test"
+  port              = 6379
+}
+
+resource "tencentcloud_redis_read_only_connection" "foo" {
+  redis_id = tencentcloud_redis_instance.foo.id
+}
+```
+
+Import
+
+Redis read-only connection can be imported using the redis instance id, e.g.
+
+```
+$ terraform import tencentcloud_redis_read_only_connection.foo redis-id
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudRedisReadOnlyConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudRedisReadOnlyConnectionCreate,
+		Read:   resourceTencentCloudRedisReadOnlyConnectionRead,
+		Update: resourceTencentCloudRedisReadOnlyConnectionUpdate,
+		Delete: resourceTencentCloudRedisReadOnlyConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"redis_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the redis instance to enable read-only replica routing on.",
+			},
+			"readonly_policy": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Roles to route traffic to, `master` and/or `replication`. If omitted, the API default applies: write to master, read from replicas.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudRedisReadOnlyConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_read_only_connection.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisId := d.Get("redis_id").(string)
+	policy := helper.InterfacesStrings(d.Get("readonly_policy").(*schema.Set).List())
+
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if err := redisService.EnableReplicaReadonly(ctx, redisId, policy); err != nil {
+		return err
+	}
+
+	d.SetId(redisId)
+
+	return resourceTencentCloudRedisReadOnlyConnectionRead(d, meta)
+}
+
+func resourceTencentCloudRedisReadOnlyConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_read_only_connection.read")()
+
+	_ = d.Set("redis_id", d.Id())
+
+	return nil
+}
+
+func resourceTencentCloudRedisReadOnlyConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_read_only_connection.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	if !d.HasChange("readonly_policy") {
+		return resourceTencentCloudRedisReadOnlyConnectionRead(d, meta)
+	}
+
+	redisId := d.Id()
+	policy := helper.InterfacesStrings(d.Get("readonly_policy").(*schema.Set).List())
+
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if err := redisService.EnableReplicaReadonly(ctx, redisId, policy); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudRedisReadOnlyConnectionRead(d, meta)
+}
+
+func resourceTencentCloudRedisReadOnlyConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_redis_read_only_connection.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
+	return redisService.DisableReplicaReadonly(ctx, d.Id())
+}
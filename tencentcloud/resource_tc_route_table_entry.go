@@ -34,6 +34,36 @@ resource "tencentcloud_route_table_entry" "instance" {
   description            = "ci-test-route-table-entry"
 }
 ```
+
+IPv6 destination with ECMP next hops
+
+```hcl
+resource "tencentcloud_route_table_entry" "ecmp" {
+  route_table_id         = tencentcloud_route_table.foo.id
+  destination_cidr_block = "2001:db8::/32"
+  description             = "ci-test-ecmp-route-table-entry"
+
+  next_hops {
+    next_type = "CVM"
+    next_hub  = "ins-aaaaaaaa"
+    weight    = 100
+  }
+
+  next_hops {
+    next_type = "CVM"
+    next_hub  = "ins-bbbbbbbb"
+    weight    = 100
+  }
+}
+```
+
+Import
+
+Route table entry can be imported using the route table id and destination CIDR block, e.g.
+
+```
+$ terraform import tencentcloud_route_table_entry.instance rtb-xxxxxxxx#10.4.4.0/24
+```
 */
 package tencentcloud
 
@@ -53,6 +83,9 @@ func resourceTencentCloudVpcRouteEntry() *schema.Resource {
 		Create: resourceTencentCloudVpcRouteEntryCreate,
 		Read:   resourceTencentCloudVpcRouteEntryRead,
 		Delete: resourceTencentCloudVpcRouteEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
@@ -66,20 +99,56 @@ func resourceTencentCloudVpcRouteEntry() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validateCIDRNetworkAddress,
-				Description:  "Destination address block.",
+				Description:  "Destination address block, can be an IPv4 or IPv6 CIDR.",
 			},
 			"next_type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validateAllowedStringValue(ALL_GATE_WAY_TYPES),
-				Description:  "Type of next-hop, and available values include CVM, VPN, DIRECTCONNECT, PEERCONNECTION, SSLVPN, NAT, NORMAL_CVM, EIP and CCN.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"next_hops"},
+				ValidateFunc:  validateAllowedStringValue(ALL_GATE_WAY_TYPES),
+				Description:   "Type of next-hop, and available values include CVM, VPN, DIRECTCONNECT, PEERCONNECTION, SSLVPN, NAT, NORMAL_CVM, EIP and CCN. Conflicts with `next_hops`, required if `next_hops` is not set.",
 			},
 			"next_hub": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "ID of next-hop gateway. Note: when 'next_type' is EIP, GatewayId should be '0'.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"next_hops"},
+				Description:   "ID of next-hop gateway. Note: when 'next_type' is EIP, GatewayId should be '0'. Conflicts with `next_hops`, required if `next_hops` is not set.",
+			},
+			"next_hops": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"next_type", "next_hub"},
+				Description:   "ECMP next hops sharing `destination_cidr_block`, each published as its own route entry. Conflicts with `next_type`/`next_hub`, required if they are not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"next_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(ALL_GATE_WAY_TYPES),
+							Description:  "Type of this hop's next-hop, see `next_type` above for the available values.",
+						},
+						"next_hub": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of this hop's next-hop gateway.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     100,
+							Description: "Relative weight of this hop for equal-cost multi-path routing.",
+						},
+						"route_entry_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the underlying route entry this hop was published as.",
+						},
+					},
+				},
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -99,47 +168,31 @@ func resourceTencentCloudVpcRouteEntryCreate(d *schema.ResourceData, meta interf
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	var (
-		description          = ""
-		routeTableId         = ""
-		destinationCidrBlock = ""
-		nextType             = ""
-		nextHub              = ""
-	)
+	routeTableId := d.Get("route_table_id").(string)
+	destinationCidrBlock := d.Get("destination_cidr_block").(string)
+	description := d.Get("description").(string)
 
-	if temp, ok := d.GetOk("description"); ok {
-		description = temp.(string)
-	}
-	if temp, ok := d.GetOk("route_table_id"); ok {
-		routeTableId = temp.(string)
-	}
-	if temp, ok := d.GetOk("destination_cidr_block"); ok {
-		destinationCidrBlock = temp.(string)
-	}
-	if temp, ok := d.GetOk("next_type"); ok {
-		nextType = temp.(string)
-	}
-	if temp, ok := d.GetOk("next_hub"); ok {
-		nextHub = temp.(string)
-	}
-
-	if routeTableId == "" || destinationCidrBlock == "" || nextType == "" || nextHub == "" {
-		return fmt.Errorf("some needed fields is empty string")
-	}
-
-	if nextType == GATE_WAY_TYPE_EIP && nextHub != "0" {
-		return fmt.Errorf("if next_type is %s, next_hub can only be \"0\" ", GATE_WAY_TYPE_EIP)
+	hops, err := routeTableEntryHopsFromSchema(d)
+	if err != nil {
+		return err
 	}
 
-	entryId, err := service.CreateRoutes(ctx, routeTableId, destinationCidrBlock, nextType, nextHub, description)
+	entryIds := make([]uint64, 0, len(hops))
+	for _, hop := range hops {
+		if hop.nextType == GATE_WAY_TYPE_EIP && hop.nextHub != "0" {
+			return fmt.Errorf("if next_type is %s, next_hub can only be \"0\" ", GATE_WAY_TYPE_EIP)
+		}
 
-	if err != nil {
-		return err
+		entryId, err := service.CreateRoutes(ctx, routeTableId, destinationCidrBlock, hop.nextType, hop.nextHub, hop.weight, description)
+		if err != nil {
+			return err
+		}
+		entryIds = append(entryIds, entryId)
 	}
 
-	d.SetId(fmt.Sprintf("%d.%s", entryId, routeTableId))
+	d.SetId(buildRouteTableEntryId(routeTableId, destinationCidrBlock, entryIds))
 
-	return nil
+	return resourceTencentCloudVpcRouteEntryRead(d, meta)
 }
 
 func resourceTencentCloudVpcRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
@@ -151,12 +204,13 @@ func resourceTencentCloudVpcRouteEntryRead(d *schema.ResourceData, meta interfac
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), ".")
-	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+	routeTableId, destinationCidrBlock, entryIds, imported, err := parseRouteTableEntryId(d.Id())
+	if err != nil {
+		return err
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		info, has, e := service.DescribeRouteTable(ctx, items[1])
+
+	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		info, has, e := service.DescribeRouteTable(ctx, routeTableId)
 		if e != nil {
 			return retryError(e)
 		}
@@ -171,23 +225,61 @@ func resourceTencentCloudVpcRouteEntryRead(d *schema.ResourceData, meta interfac
 			return resource.NonRetryableError(e)
 		}
 
+		// A freshly-imported id (just route_table_id#destination_cidr_block,
+		// per the Import section above) doesn't know which route_entry_ids
+		// belong to it yet, so this first read after import claims every
+		// entry that shares destination_cidr_block, the same way `terraform
+		// import` always has for this resource. Every other read - including
+		// the one right after Create - only looks at the route_entry_ids this
+		// resource itself created, so a sibling tencentcloud_route_table_entry
+		// aiming at the same destination_cidr_block (the documented
+		// multi-resource ECMP pattern) can't bleed its hops into this one.
+		entryIdSet := make(map[uint64]bool, len(entryIds))
+		for _, id := range entryIds {
+			entryIdSet[id] = true
+		}
+
+		var matched []map[string]interface{}
+		var matchedIds []uint64
+		var lastDescription string
 		for _, v := range info.entryInfos {
-			if fmt.Sprintf("%d", v.routeEntryId) == items[0] {
-				_ = d.Set("description", v.description)
-				_ = d.Set("route_table_id", items[1])
-				_ = d.Set("destination_cidr_block", v.destinationCidr)
-				_ = d.Set("next_type", v.nextType)
-				_ = d.Set("next_hub", v.nextBub)
-				return nil
+			if v.destinationCidr != destinationCidrBlock {
+				continue
 			}
+			if !imported && !entryIdSet[v.routeEntryId] {
+				continue
+			}
+			lastDescription = v.description
+			matchedIds = append(matchedIds, v.routeEntryId)
+			matched = append(matched, map[string]interface{}{
+				"next_type":      v.nextType,
+				"next_hub":       v.nextBub,
+				"weight":         v.weight,
+				"route_entry_id": fmt.Sprintf("%d", v.routeEntryId),
+			})
+		}
+
+		if len(matched) == 0 {
+			d.SetId("")
+			return nil
 		}
-		d.SetId("")
+
+		_ = d.Set("route_table_id", routeTableId)
+		_ = d.Set("destination_cidr_block", destinationCidrBlock)
+		_ = d.Set("description", lastDescription)
+
+		if len(matched) == 1 && len(d.Get("next_hops").([]interface{})) == 0 {
+			_ = d.Set("next_type", matched[0]["next_type"])
+			_ = d.Set("next_hub", matched[0]["next_hub"])
+		} else {
+			_ = d.Set("next_type", "")
+			_ = d.Set("next_hub", "")
+			_ = d.Set("next_hops", matched)
+		}
+
+		d.SetId(buildRouteTableEntryId(routeTableId, destinationCidrBlock, matchedIds))
 		return nil
 	})
-	if err != nil {
-		return err
-	}
-	return nil
 }
 
 func resourceTencentCloudVpcRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
@@ -196,30 +288,103 @@ func resourceTencentCloudVpcRouteEntryDelete(d *schema.ResourceData, meta interf
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-
-	items := strings.Split(d.Id(), ".")
-	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
-	}
-
-	routeTableId := items[1]
-	entryId, err := strconv.ParseUint(items[0], 10, 64)
+	routeTableId, _, entryIds, _, err := parseRouteTableEntryId(d.Id())
 	if err != nil {
-		return fmt.Errorf("entry id be destroyed, we can not get route entry id")
+		return err
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		if err := service.DeleteRoutes(ctx, routeTableId, entryId); err != nil {
-			if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
-				if sdkErr.Code == VPCNotFound {
-					return nil
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		for _, entryId := range entryIds {
+			if err := service.DeleteRoutes(ctx, routeTableId, entryId); err != nil {
+				if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+					if sdkErr.Code == VPCNotFound {
+						continue
+					}
 				}
+				return resource.RetryableError(err)
 			}
-			return resource.RetryableError(err)
 		}
 		return nil
 	})
+}
 
-	return err
+// routeTableEntryHop is one ECMP next hop to publish as its own route entry
+// under the same destination_cidr_block.
+type routeTableEntryHop struct {
+	nextType string
+	nextHub  string
+	weight   int
+}
+
+// routeTableEntryHopsFromSchema reads either the single next_type/next_hub
+// pair or the next_hops block list, whichever was set, into a uniform list
+// of hops to create.
+func routeTableEntryHopsFromSchema(d *schema.ResourceData) ([]routeTableEntryHop, error) {
+	if v, ok := d.GetOk("next_hops"); ok {
+		raw := v.([]interface{})
+		hops := make([]routeTableEntryHop, 0, len(raw))
+		for _, item := range raw {
+			m := item.(map[string]interface{})
+			hops = append(hops, routeTableEntryHop{
+				nextType: m["next_type"].(string),
+				nextHub:  m["next_hub"].(string),
+				weight:   m["weight"].(int),
+			})
+		}
+		return hops, nil
+	}
+
+	nextType := d.Get("next_type").(string)
+	nextHub := d.Get("next_hub").(string)
+	if nextType == "" || nextHub == "" {
+		return nil, fmt.Errorf("either `next_hops`, or both `next_type` and `next_hub`, must be set")
+	}
+
+	return []routeTableEntryHop{{nextType: nextType, nextHub: nextHub, weight: 100}}, nil
+}
+
+// buildRouteTableEntryId joins the route table id, the shared destination
+// CIDR block and the route_entry_ids this resource actually created into a
+// single id, so a later Read/Delete only ever touches entries this resource
+// instance owns instead of every entry sharing destination_cidr_block.
+func buildRouteTableEntryId(routeTableId, destinationCidrBlock string, entryIds []uint64) string {
+	idStrings := make([]string, 0, len(entryIds))
+	for _, entryId := range entryIds {
+		idStrings = append(idStrings, strconv.FormatUint(entryId, 10))
+	}
+	return strings.Join([]string{routeTableId, destinationCidrBlock, strings.Join(idStrings, ",")}, FILED_SP)
+}
+
+// parseRouteTableEntryId splits an id back into the route table id, the
+// destination CIDR block and the route_entry_ids this resource owns. A plain
+// strings.Split on "." would break on IPv4 CIDRs, which already contain dots,
+// hence FILED_SP. The id produced by `terraform import` (see the Import
+// section above) only has the first two parts - imported is true in that
+// case so Read knows to discover and adopt the matching entries instead of
+// filtering on an empty owned set.
+func parseRouteTableEntryId(id string) (routeTableId, destinationCidrBlock string, entryIds []uint64, imported bool, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 && len(parts) != 3 {
+		err = fmt.Errorf("the resource id is corrupted")
+		return
+	}
+	routeTableId, destinationCidrBlock = parts[0], parts[1]
+	if len(parts) == 2 {
+		imported = true
+		return
+	}
+	if parts[2] == "" {
+		return
+	}
+	for _, s := range strings.Split(parts[2], ",") {
+		entryId, e := strconv.ParseUint(s, 10, 64)
+		if e != nil {
+			err = fmt.Errorf("the resource id is corrupted")
+			return
+		}
+		entryIds = append(entryIds, entryId)
+	}
+	return
 }
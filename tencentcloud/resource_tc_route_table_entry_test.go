@@ -0,0 +1,183 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTencentCloudRouteTableEntry_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteTableEntryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableEntryExists("tencentcloud_route_table_entry.instance"),
+					resource.TestCheckResourceAttr("tencentcloud_route_table_entry.instance", "destination_cidr_block", "10.4.4.0/24"),
+					resource.TestCheckResourceAttr("tencentcloud_route_table_entry.instance", "next_type", "EIP"),
+					resource.TestCheckResourceAttr("tencentcloud_route_table_entry.instance", "next_hub", "0"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_route_table_entry.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudRouteTableEntry_ecmp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteTableEntryConfig_ecmp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableEntryExists("tencentcloud_route_table_entry.ecmp"),
+					resource.TestCheckResourceAttr("tencentcloud_route_table_entry.ecmp", "destination_cidr_block", "2001:db8::/32"),
+					resource.TestCheckResourceAttr("tencentcloud_route_table_entry.ecmp", "next_hops.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_route_table_entry.ecmp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRouteTableEntryDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_route_table_entry" {
+			continue
+		}
+
+		routeTableId, destinationCidrBlock, err := parseRouteTableEntryId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		info, has, err := service.DescribeRouteTable(ctx, routeTableId)
+		if err != nil {
+			return err
+		}
+		if has == 0 {
+			continue
+		}
+
+		for _, v := range info.entryInfos {
+			if v.destinationCidr == destinationCidrBlock {
+				return fmt.Errorf("route table entry still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckRouteTableEntryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("route table entry %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("route table entry id is not set")
+		}
+
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+		routeTableId, destinationCidrBlock, err := parseRouteTableEntryId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+		info, has, err := service.DescribeRouteTable(ctx, routeTableId)
+		if err != nil {
+			return err
+		}
+		if has == 0 {
+			return fmt.Errorf("route table %s is not found", routeTableId)
+		}
+
+		for _, v := range info.entryInfos {
+			if v.destinationCidr == destinationCidrBlock {
+				return nil
+			}
+		}
+		return fmt.Errorf("route table entry is not found: %s", rs.Primary.ID)
+	}
+}
+
+const testAccRouteTableEntryConfig = `
+variable "availability_zone" {
+  default = "na-siliconvalley-1"
+}
+
+resource "tencentcloud_vpc" "foo" {
+  name       = "ci-test-route-entry-vpc"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_route_table" "foo" {
+  vpc_id = tencentcloud_vpc.foo.id
+  name   = "ci-test-route-entry-rt"
+}
+
+resource "tencentcloud_route_table_entry" "instance" {
+  route_table_id         = tencentcloud_route_table.foo.id
+  destination_cidr_block = "10.4.4.0/24"
+  next_type               = "EIP"
+  next_hub                = "0"
+  description              = "ci-test-route-table-entry"
+}
+`
+
+const testAccRouteTableEntryConfig_ecmp = `
+variable "availability_zone" {
+  default = "na-siliconvalley-1"
+}
+
+resource "tencentcloud_vpc" "foo" {
+  name       = "ci-test-route-entry-vpc"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_route_table" "foo" {
+  vpc_id = tencentcloud_vpc.foo.id
+  name   = "ci-test-route-entry-rt"
+}
+
+resource "tencentcloud_route_table_entry" "ecmp" {
+  route_table_id         = tencentcloud_route_table.foo.id
+  destination_cidr_block = "2001:db8::/32"
+  description              = "ci-test-ecmp-route-table-entry"
+
+  next_hops {
+    next_type = "CVM"
+    next_hub  = "ins-aaaaaaaa"
+    weight    = 100
+  }
+
+  next_hops {
+    next_type = "CVM"
+    next_hub  = "ins-bbbbbbbb"
+    weight    = 100
+  }
+}
+`
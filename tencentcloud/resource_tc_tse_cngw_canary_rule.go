@@ -0,0 +1,289 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a TSE cloud-native API gateway canary rule, diverting a
+percentage of a service's traffic to a canary target service based on header/param
+conditions.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_tse_cngw_canary_rule" "foo" {
+  gateway_id     = tencentcloud_tse_cngw_gateway.foo.id
+  service_name   = tencentcloud_tse_cngw_service.foo.name
+  priority       = 100
+  enabled        = true
+  canary_service = "foo-canary"
+  balanced_percent = 20
+
+  condition_list {
+    type     = "header"
+    key      = "X-Canary"
+    operator = "eq"
+    value    = "true"
+  }
+}
+```
+
+Import
+
+TSE cloud-native API gateway canary rule can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tse_cngw_canary_rule.foo gateway-id#service-name
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tse "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tse/v20201207"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudTseCngwCanaryRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTseCngwCanaryRuleCreate,
+		Read:   resourceTencentCloudTseCngwCanaryRuleRead,
+		Update: resourceTencentCloudTseCngwCanaryRuleUpdate,
+		Delete: resourceTencentCloudTseCngwCanaryRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the cloud-native API gateway that this canary rule belongs to.",
+			},
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the service that this canary rule applies to.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Priority of the canary rule, larger values are evaluated first.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicate whether the canary rule is enabled.",
+			},
+			"canary_service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the canary target service. Either `canary_service` or `balanced_percent` must be set.",
+			},
+			"balanced_percent": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Percentage (0-100) of matched traffic diverted to the canary target service.",
+			},
+			"condition_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Match condition list evaluated against the incoming request. All conditions must match.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"header", "query", "cookie"}),
+							Description:  "Type of the match condition, valid values are `header`, `query`, `cookie`.",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key matched by this condition, e.g. the header name.",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"eq", "ne", "lt", "le", "gt", "ge", "regex"}),
+							Description:  "Comparison operator of this condition, valid values are `eq`, `ne`, `lt`, `le`, `gt`, `ge`, `regex`.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value compared against the matched key.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func tseCngwCanaryRuleId(gatewayId, serviceName string) string {
+	return gatewayId + FILED_SP + serviceName
+}
+
+func parseTseCngwCanaryRuleId(id string) (gatewayId, serviceName string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid TSE cloud-native API gateway canary rule id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudTseCngwCanaryRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	gatewayId := d.Get("gateway_id").(string)
+	serviceName := d.Get("service_name").(string)
+
+	request, err := tseCngwCanaryRuleCreateRequest(d, gatewayId, serviceName)
+	if err != nil {
+		return err
+	}
+
+	if err := tseService.CreateTseCngwCanaryRule(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s create TSE cloud-native API gateway canary rule failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(tseCngwCanaryRuleId(gatewayId, serviceName))
+
+	return resourceTencentCloudTseCngwCanaryRuleRead(d, meta)
+}
+
+func tseCngwCanaryRuleCreateRequest(d *schema.ResourceData, gatewayId, serviceName string) (*tse.CreateCloudNativeAPIGatewayCanaryRuleRequest, error) {
+	request := tse.NewCreateCloudNativeAPIGatewayCanaryRuleRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+	request.Priority = helper.IntInt64(d.Get("priority").(int))
+	request.Enabled = helper.Bool(d.Get("enabled").(bool))
+	request.BalancedService = helper.String(d.Get("canary_service").(string))
+	request.BalancedPercent = helper.IntInt64(d.Get("balanced_percent").(int))
+
+	if v, ok := d.GetOk("condition_list"); ok {
+		for _, item := range v.([]interface{}) {
+			condition := item.(map[string]interface{})
+			request.ConditionList = append(request.ConditionList, &tse.GatewayCanaryRuleCondition{
+				Type:     helper.String(condition["type"].(string)),
+				Key:      helper.String(condition["key"].(string)),
+				Operator: helper.String(condition["operator"].(string)),
+				Value:    helper.String(condition["value"].(string)),
+			})
+		}
+	}
+
+	return request, nil
+}
+
+func resourceTencentCloudTseCngwCanaryRuleRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId, serviceName, err := parseTseCngwCanaryRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	rule, err := tseService.DescribeTseCngwCanaryRuleById(ctx, gatewayId, serviceName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE cloud-native API gateway canary rule failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the canary rule has been deleted out-of-band, recreate it on next apply
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("gateway_id", gatewayId)
+	_ = d.Set("service_name", serviceName)
+	_ = d.Set("priority", rule.Priority)
+	_ = d.Set("enabled", rule.Enabled)
+	_ = d.Set("canary_service", rule.BalancedService)
+	_ = d.Set("balanced_percent", rule.BalancedPercent)
+
+	conditions := make([]map[string]interface{}, 0, len(rule.ConditionList))
+	for _, c := range rule.ConditionList {
+		conditions = append(conditions, map[string]interface{}{
+			"type":     c.Type,
+			"key":      c.Key,
+			"operator": c.Operator,
+			"value":    c.Value,
+		})
+	}
+	_ = d.Set("condition_list", conditions)
+
+	return nil
+}
+
+func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.update")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, serviceName, err := parseTseCngwCanaryRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	createRequest, err := tseCngwCanaryRuleCreateRequest(d, gatewayId, serviceName)
+	if err != nil {
+		return err
+	}
+	request := tse.NewModifyCloudNativeAPIGatewayCanaryRuleRequest()
+	request.GatewayId = createRequest.GatewayId
+	request.ServiceName = createRequest.ServiceName
+	request.Priority = createRequest.Priority
+	request.Enabled = createRequest.Enabled
+	request.BalancedService = createRequest.BalancedService
+	request.BalancedPercent = createRequest.BalancedPercent
+	request.ConditionList = createRequest.ConditionList
+
+	if _, err := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayCanaryRule(request); err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+
+	return resourceTencentCloudTseCngwCanaryRuleRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwCanaryRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId, serviceName, err := parseTseCngwCanaryRuleId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := tseService.DeleteTseCngwCanaryRule(ctx, gatewayId, serviceName); err != nil {
+		log.Printf("[CRITAL]%s delete TSE cloud-native API gateway canary rule failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
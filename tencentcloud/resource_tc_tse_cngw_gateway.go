@@ -0,0 +1,315 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a TSE cloud-native API gateway (Kong-based).
+
+Example Usage
+
+```hcl
+resource "tencentcloud_tse_cngw_gateway" "foo" {
+  name           = "terraform-test"
+  gateway_type   = "kong"
+  node_spec_id   = "1C2G"
+  node_num       = 2
+  vpc_id         = "vpc-dk8zmwuf"
+  subnet_id      = "subnet-fzbrn2wf"
+  description    = "create by terraform"
+  enable_internet = false
+
+  tags = {
+    test = "test"
+  }
+}
+```
+
+Import
+
+TSE cloud-native API gateway can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tse_cngw_gateway.foo gateway-id
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tse "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tse/v20201207"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudTseCngwGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTseCngwGatewayCreate,
+		Read:   resourceTencentCloudTseCngwGatewayRead,
+		Update: resourceTencentCloudTseCngwGatewayUpdate,
+		Delete: resourceTencentCloudTseCngwGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cloud-native API gateway.",
+			},
+			"gateway_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      TSE_GATEWAY_TYPE_KONG,
+				ValidateFunc: validateAllowedStringValue([]string{TSE_GATEWAY_TYPE_KONG}),
+				Description:  "Type of the cloud-native API gateway, only `kong` is supported for now.",
+			},
+			"node_spec_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Spec id of the gateway node, e.g. `1C2G`.",
+			},
+			"node_num": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Number of the gateway nodes.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the subnet.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the cloud-native API gateway.",
+			},
+			"enable_internet": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to enable the public network access, default is `false`.",
+			},
+			"internet_max_bandwidth_out": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Public network egress bandwidth in Mbps, only takes effect when `enable_internet` is `true`.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A list of tags used to associate different resources.",
+			},
+
+			// Computed values
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the cloud-native API gateway.",
+			},
+			"internal_http_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internal HTTP access address of the gateway.",
+			},
+			"public_ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Public IP address list of the gateway, only set when `enable_internet` is `true`.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the cloud-native API gateway.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudTseCngwGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := tse.NewCreateCloudNativeAPIGatewayRequest()
+	request.Name = helper.String(d.Get("name").(string))
+	request.GatewayVersion = helper.String(d.Get("gateway_type").(string))
+	request.NodeConfig = &tse.NodeConfig{
+		Specification: helper.String(d.Get("node_spec_id").(string)),
+		Number:        helper.IntUint64(d.Get("node_num").(int)),
+	}
+	request.VpcConfig = &tse.VpcConfig{
+		VpcId:    helper.String(d.Get("vpc_id").(string)),
+		SubnetId: helper.String(d.Get("subnet_id").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = helper.String(v.(string))
+	}
+	if d.Get("enable_internet").(bool) {
+		request.EnableCls = helper.Bool(false)
+		request.InternetMaxBandwidthOut = helper.IntInt64(d.Get("internet_max_bandwidth_out").(int))
+	}
+
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		for k, v := range tags {
+			request.Tags = append(request.Tags, &tse.InstanceTagInfo{
+				TagKey:   helper.String(k),
+				TagValue: helper.String(v),
+			})
+		}
+	}
+
+	gatewayId, err := tseService.CreateTseCngwGateway(ctx, request)
+	if err != nil {
+		return err
+	}
+	d.SetId(gatewayId)
+
+	err = resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		gateway, e := tseService.DescribeTseCngwGatewayById(ctx, gatewayId)
+		if e != nil {
+			return resource.NonRetryableError(e)
+		}
+		if gateway == nil {
+			return resource.RetryableError(fmt.Errorf("cloud-native API gateway %s is not found yet, still creating", gatewayId))
+		}
+		if gateway.Status != nil && *gateway.Status == TSE_STATUS_RUNNING {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("cloud-native API gateway %s is still creating", gatewayId))
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create TSE cloud-native API gateway failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudTseCngwGatewayRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	gateway, err := tseService.DescribeTseCngwGatewayById(ctx, d.Id())
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE cloud-native API gateway failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the gateway has been deleted out-of-band, recreate it on next apply
+	if gateway == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", gateway.Name)
+	_ = d.Set("description", gateway.Description)
+	_ = d.Set("status", gateway.Status)
+	_ = d.Set("internal_http_address", gateway.InternalHttpAddress)
+	_ = d.Set("create_time", gateway.CreateTime)
+	if gateway.NodeConfig != nil {
+		_ = d.Set("node_spec_id", gateway.NodeConfig.Specification)
+		_ = d.Set("node_num", gateway.NodeConfig.Number)
+	}
+	if gateway.VpcConfig != nil {
+		_ = d.Set("vpc_id", gateway.VpcConfig.VpcId)
+		_ = d.Set("subnet_id", gateway.VpcConfig.SubnetId)
+	}
+	if len(gateway.PublicIpAddresses) > 0 {
+		_ = d.Set("enable_internet", true)
+		_ = d.Set("public_ip_addresses", helper.StringsInterfaces(gateway.PublicIpAddresses))
+	}
+
+	return nil
+}
+
+func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId := d.Id()
+	client := meta.(*TencentCloudClient).apiV3Conn
+	tagService := TagService{client: client}
+	region := client.Region
+
+	d.Partial(true)
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("node_spec_id") || d.HasChange("node_num") {
+		request := tse.NewModifyCloudNativeAPIGatewayRequest()
+		request.GatewayId = &gatewayId
+		request.Name = helper.String(d.Get("name").(string))
+		request.Description = helper.String(d.Get("description").(string))
+		request.NodeConfig = &tse.NodeConfig{
+			Specification: helper.String(d.Get("node_spec_id").(string)),
+			Number:        helper.IntUint64(d.Get("node_num").(int)),
+		}
+		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			_, e := client.UseTseClient().ModifyCloudNativeAPIGateway(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s modify TSE cloud-native API gateway failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+		d.SetPartial("name")
+		d.SetPartial("description")
+		d.SetPartial("node_spec_id")
+		d.SetPartial("node_num")
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+		resourceName := BuildTagResourceName("tse", "gateway", region, gatewayId)
+		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudTseCngwGatewayRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := tseService.DeleteTseCngwGateway(ctx, d.Id()); err != nil {
+		log.Printf("[CRITAL]%s delete TSE cloud-native API gateway failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a TSE cloud-native API gateway route bound to a service.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_tse_cngw_route" "foo" {
+  gateway_id   = tencentcloud_tse_cngw_gateway.foo.id
+  service_name = tencentcloud_tse_cngw_service.foo.name
+  name         = "terraform-test"
+  paths        = ["/foo"]
+  methods      = ["GET", "POST"]
+  protocols    = ["http"]
+}
+```
+
+Import
+
+TSE cloud-native API gateway route can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tse_cngw_route.foo gateway-id#service-name#route-name
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tse "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tse/v20201207"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudTseCngwRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTseCngwRouteCreate,
+		Read:   resourceTencentCloudTseCngwRouteRead,
+		Update: resourceTencentCloudTseCngwRouteUpdate,
+		Delete: resourceTencentCloudTseCngwRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the cloud-native API gateway that this route belongs to.",
+			},
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the service that this route forwards traffic to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the route.",
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Request path list matched by this route.",
+			},
+			"methods": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "HTTP method list matched by this route, e.g. `GET`, `POST`. Matches any method when empty.",
+			},
+			"protocols": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Protocol list handled by this route, valid values are `http`, `https`. Default is `[\"http\", \"https\"]`.",
+			},
+			"preserve_host": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to forward the original `Host` header to the upstream targets.",
+			},
+			"strip_path": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicate whether to strip the matched path prefix before forwarding to the upstream targets.",
+			},
+		},
+	}
+}
+
+func tseCngwRouteId(gatewayId, serviceName, routeName string) string {
+	return gatewayId + FILED_SP + serviceName + FILED_SP + routeName
+}
+
+func parseTseCngwRouteId(id string) (gatewayId, serviceName, routeName string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid TSE cloud-native API gateway route id: %s", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func resourceTencentCloudTseCngwRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_route.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	gatewayId := d.Get("gateway_id").(string)
+	serviceName := d.Get("service_name").(string)
+	routeName := d.Get("name").(string)
+
+	request := tse.NewCreateCloudNativeAPIGatewayRouteRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+	request.Name = &routeName
+	request.Paths = helper.InterfacesStrings(d.Get("paths").([]interface{}))
+	request.PreserveHost = helper.Bool(d.Get("preserve_host").(bool))
+	request.StripPath = helper.Bool(d.Get("strip_path").(bool))
+	if v, ok := d.GetOk("methods"); ok {
+		request.Methods = helper.InterfacesStrings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("protocols"); ok {
+		request.Protocols = helper.InterfacesStrings(v.([]interface{}))
+	}
+
+	if err := tseService.CreateTseCngwRoute(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s create TSE cloud-native API gateway route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(tseCngwRouteId(gatewayId, serviceName, routeName))
+
+	return resourceTencentCloudTseCngwRouteRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwRouteRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_route.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId, serviceName, routeName, err := parseTseCngwRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	route, err := tseService.DescribeTseCngwRouteById(ctx, gatewayId, serviceName, routeName)
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE cloud-native API gateway route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the route has been deleted out-of-band, recreate it on next apply
+	if route == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("gateway_id", gatewayId)
+	_ = d.Set("service_name", serviceName)
+	_ = d.Set("name", route.Name)
+	_ = d.Set("paths", helper.StringsInterfaces(route.Paths))
+	_ = d.Set("methods", helper.StringsInterfaces(route.Methods))
+	_ = d.Set("protocols", helper.StringsInterfaces(route.Protocols))
+	_ = d.Set("preserve_host", route.PreserveHost)
+	_ = d.Set("strip_path", route.StripPath)
+
+	return nil
+}
+
+func resourceTencentCloudTseCngwRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_route.update")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, serviceName, routeName, err := parseTseCngwRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := tse.NewModifyCloudNativeAPIGatewayRouteRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+	request.Name = &routeName
+	request.Paths = helper.InterfacesStrings(d.Get("paths").([]interface{}))
+	request.PreserveHost = helper.Bool(d.Get("preserve_host").(bool))
+	request.StripPath = helper.Bool(d.Get("strip_path").(bool))
+	if v, ok := d.GetOk("methods"); ok {
+		request.Methods = helper.InterfacesStrings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("protocols"); ok {
+		request.Protocols = helper.InterfacesStrings(v.([]interface{}))
+	}
+
+	if _, err := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayRoute(request); err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+
+	return resourceTencentCloudTseCngwRouteRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_route.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId, serviceName, routeName, err := parseTseCngwRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := tseService.DeleteTseCngwRoute(ctx, gatewayId, serviceName, routeName); err != nil {
+		log.Printf("[CRITAL]%s delete TSE cloud-native API gateway route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
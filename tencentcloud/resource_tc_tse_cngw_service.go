@@ -0,0 +1,292 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a TSE cloud-native API gateway service, the Kong
+upstream service that routes are ultimately bound to.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_tse_cngw_service" "foo" {
+  gateway_id = tencentcloud_tse_cngw_gateway.foo.id
+  name       = "terraform-test"
+  path       = "/"
+  protocol   = "http"
+  timeout    = 5000
+  retries    = 3
+
+  upstream_type = "IPList"
+
+  targets {
+    host   = "172.16.0.1"
+    port   = 80
+    weight = 100
+  }
+}
+```
+
+Import
+
+TSE cloud-native API gateway service can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tse_cngw_service.foo gateway-id#service-name
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tse "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tse/v20201207"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudTseCngwService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTseCngwServiceCreate,
+		Read:   resourceTencentCloudTseCngwServiceRead,
+		Update: resourceTencentCloudTseCngwServiceUpdate,
+		Delete: resourceTencentCloudTseCngwServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the cloud-native API gateway that this service belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the service.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/",
+				Description: "Default request path forwarded to the upstream targets.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "http",
+				ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+				Description:  "Protocol used to talk to the upstream targets, valid values are `http`, `https`.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     60000,
+				Description: "Upstream response timeout in milliseconds.",
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "Number of retries on upstream connection failure.",
+			},
+			"upstream_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IPList",
+				ValidateFunc: validateAllowedStringValue([]string{"IPList", "HOST_IP"}),
+				Description:  "Type of the upstream, valid values are `IPList`, `HOST_IP`.",
+			},
+			"targets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Upstream target list, required when `upstream_type` is `IPList`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Host/IP of the upstream target.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Port of the upstream target.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     100,
+							Description: "Load-balancing weight of the upstream target.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func tseCngwServiceId(gatewayId, name string) string {
+	return gatewayId + FILED_SP + name
+}
+
+func parseTseCngwServiceId(id string) (gatewayId, name string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid TSE cloud-native API gateway service id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudTseCngwServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_service.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	gatewayId := d.Get("gateway_id").(string)
+	name := d.Get("name").(string)
+
+	request := tse.NewCreateCloudNativeAPIGatewayServiceRequest()
+	request.GatewayId = &gatewayId
+	request.Name = &name
+	request.Path = helper.String(d.Get("path").(string))
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.Timeout = helper.IntInt64(d.Get("timeout").(int))
+	request.Retries = helper.IntInt64(d.Get("retries").(int))
+	request.UpstreamType = helper.String(d.Get("upstream_type").(string))
+
+	if v, ok := d.GetOk("targets"); ok {
+		for _, item := range v.([]interface{}) {
+			target := item.(map[string]interface{})
+			request.UpstreamInfo = append(request.UpstreamInfo, &tse.KongUpstreamInfo{
+				Host:   helper.String(target["host"].(string)),
+				Port:   helper.IntInt64(target["port"].(int)),
+				Weight: helper.IntInt64(target["weight"].(int)),
+			})
+		}
+	}
+
+	if err := tseService.CreateTseCngwService(ctx, request); err != nil {
+		log.Printf("[CRITAL]%s create TSE cloud-native API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(tseCngwServiceId(gatewayId, name))
+
+	return resourceTencentCloudTseCngwServiceRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwServiceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_service.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId, name, err := parseTseCngwServiceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	service, err := tseService.DescribeTseCngwServiceById(ctx, gatewayId, name)
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE cloud-native API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the service has been deleted out-of-band, recreate it on next apply
+	if service == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("gateway_id", gatewayId)
+	_ = d.Set("name", service.Name)
+	_ = d.Set("path", service.Path)
+	_ = d.Set("protocol", service.Protocol)
+	_ = d.Set("timeout", service.Timeout)
+	_ = d.Set("retries", service.Retries)
+	_ = d.Set("upstream_type", service.UpstreamType)
+
+	targets := make([]map[string]interface{}, 0, len(service.UpstreamInfo))
+	for _, t := range service.UpstreamInfo {
+		targets = append(targets, map[string]interface{}{
+			"host":   t.Host,
+			"port":   t.Port,
+			"weight": t.Weight,
+		})
+	}
+	_ = d.Set("targets", targets)
+
+	return nil
+}
+
+func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_service.update")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, name, err := parseTseCngwServiceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := tse.NewModifyCloudNativeAPIGatewayServiceRequest()
+	request.GatewayId = &gatewayId
+	request.Name = &name
+	request.Path = helper.String(d.Get("path").(string))
+	request.Protocol = helper.String(d.Get("protocol").(string))
+	request.Timeout = helper.IntInt64(d.Get("timeout").(int))
+	request.Retries = helper.IntInt64(d.Get("retries").(int))
+	request.UpstreamType = helper.String(d.Get("upstream_type").(string))
+
+	if v, ok := d.GetOk("targets"); ok {
+		for _, item := range v.([]interface{}) {
+			target := item.(map[string]interface{})
+			request.UpstreamInfo = append(request.UpstreamInfo, &tse.KongUpstreamInfo{
+				Host:   helper.String(target["host"].(string)),
+				Port:   helper.IntInt64(target["port"].(int)),
+				Weight: helper.IntInt64(target["weight"].(int)),
+			})
+		}
+	}
+
+	if _, err := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayService(request); err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+
+	return resourceTencentCloudTseCngwServiceRead(d, meta)
+}
+
+func resourceTencentCloudTseCngwServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_cngw_service.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	gatewayId, name, err := parseTseCngwServiceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := tseService.DeleteTseCngwService(ctx, gatewayId, name); err != nil {
+		log.Printf("[CRITAL]%s delete TSE cloud-native API gateway service failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,398 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a TSE (Tencent Service Engine) microservice registry or
+config center instance, backed by one of Nacos, Consul, Zookeeper, Eureka, Apollo or
+PolarisMesh.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_tse_instance" "foo" {
+  engine_type    = "nacos"
+  engine_version = "2.0.3"
+  spec_id        = "1C2G"
+  replica        = 3
+  name           = "terraform-test"
+  vpc_id         = "vpc-dk8zmwuf"
+  subnet_id      = "subnet-fzbrn2wf"
+
+  engine_admin {
+    name     = "admin"
+    password = "test12345789"
+  }
+
+  enable_public_network = true
+
+  tags = {
+    test = "test"
+  }
+}
+```
+
+Import
+
+TSE instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tse_instance.foo instance-id
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	tse "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tse/v20201207"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudTseInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTseInstanceCreate,
+		Read:   resourceTencentCloudTseInstanceRead,
+		Update: resourceTencentCloudTseInstanceUpdate,
+		Delete: resourceTencentCloudTseInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the TSE instance.",
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(TSE_ENGINE_TYPES),
+				Description:  "Engine type of the TSE instance, valid values are `nacos`, `consul`, `zookeeper`, `eureka`, `apollo`, `polaris`.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Engine version of the TSE instance, e.g. `2.0.3` for nacos.",
+			},
+			"spec_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Spec id of the TSE instance, e.g. `1C2G`.",
+			},
+			"replica": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Number of replicas of the engine cluster.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the subnet.",
+			},
+			"engine_admin": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Admin account of the engine console, required by `nacos` and `polaris`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Admin account name.",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Admin account password.",
+						},
+					},
+				},
+			},
+			"enable_public_network": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to enable the public network access, default is `false`.",
+			},
+			"internet_max_bandwidth_out": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Public network egress bandwidth in Mbps, only takes effect when `enable_public_network` is `true`.",
+			},
+			"engine_region_infos": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Cross-region deployment info of the engine cluster. Each element describes one region participating in the deployment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Region of this replica group.",
+						},
+						"replica": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Number of replicas deployed in this region.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the VPC in this region.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the subnet in this region.",
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A list of tags used to associate different resources.",
+			},
+
+			// Computed values
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the TSE instance.",
+			},
+			"intranet_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Intranet access address of the engine console/config center.",
+			},
+			"internet_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Internet access address of the engine console/config center, only set when `enable_public_network` is `true`.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the TSE instance.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudTseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_instance.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	request := tse.NewCreateEngineRequest()
+	request.EngineType = helper.String(d.Get("engine_type").(string))
+	request.EngineVersion = helper.String(d.Get("engine_version").(string))
+	request.EngineRegion = helper.String(meta.(*TencentCloudClient).apiV3Conn.Region)
+	request.TradeType = helper.IntInt64(0)
+	request.Name = helper.String(d.Get("name").(string))
+	request.EngineProductVersion = helper.String(d.Get("spec_id").(string))
+	request.EngineNodeNum = helper.IntUint64(d.Get("replica").(int))
+	request.VpcId = helper.String(d.Get("vpc_id").(string))
+	request.SubnetId = helper.String(d.Get("subnet_id").(string))
+
+	if v, ok := d.GetOk("engine_admin"); ok {
+		admin := v.([]interface{})[0].(map[string]interface{})
+		request.EngineAdminInfo = &tse.EngineAdminInfo{
+			AdminUser:     helper.String(admin["name"].(string)),
+			AdminPassword: helper.String(admin["password"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("engine_region_infos"); ok {
+		for _, item := range v.([]interface{}) {
+			info := item.(map[string]interface{})
+			request.EngineRegionInfos = append(request.EngineRegionInfos, &tse.EngineRegionInfo{
+				EngineRegion: helper.String(info["region"].(string)),
+				Replica:      helper.IntUint64(info["replica"].(int)),
+				VpcInfos: []*tse.VpcInfo{
+					{
+						VpcId:    helper.String(info["vpc_id"].(string)),
+						SubnetId: helper.String(info["subnet_id"].(string)),
+					},
+				},
+			})
+		}
+	}
+
+	if d.Get("enable_public_network").(bool) {
+		request.EnableClientInternet = helper.Bool(true)
+		if v, ok := d.GetOk("internet_max_bandwidth_out"); ok && v.(int) > 0 {
+			request.InternetMaxBandwidthOut = helper.IntInt64(v.(int))
+		}
+	}
+
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		for k, v := range tags {
+			request.Tags = append(request.Tags, &tse.InstanceTagInfo{
+				TagKey:   helper.String(k),
+				TagValue: helper.String(v),
+			})
+		}
+	}
+
+	instanceId, err := tseService.CreateTseInstance(ctx, request)
+	if err != nil {
+		return err
+	}
+	d.SetId(instanceId)
+
+	err = resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		instance, e := tseService.DescribeTseInstanceById(ctx, instanceId)
+		if e != nil {
+			return resource.NonRetryableError(e)
+		}
+		if instance == nil {
+			return resource.RetryableError(fmt.Errorf("TSE instance %s is not found yet, still creating", instanceId))
+		}
+		if instance.Status != nil && *instance.Status == TSE_STATUS_RUNNING {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("TSE instance %s is still creating", instanceId))
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create TSE instance failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resourceTencentCloudTseInstanceRead(d, meta)
+}
+
+func resourceTencentCloudTseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_instance.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instance, err := tseService.DescribeTseInstanceById(ctx, d.Id())
+	if err != nil {
+		log.Printf("[CRITAL]%s read TSE instance failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the instance has been deleted out-of-band, recreate it on next apply
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("name", instance.Name)
+	_ = d.Set("engine_type", instance.EngineType)
+	_ = d.Set("engine_version", instance.EngineVersion)
+	_ = d.Set("spec_id", instance.EngineProductVersion)
+	_ = d.Set("replica", instance.EngineNodeNum)
+	_ = d.Set("vpc_id", instance.VpcId)
+	_ = d.Set("subnet_id", instance.SubnetId)
+	_ = d.Set("status", instance.Status)
+	_ = d.Set("intranet_address", instance.IntranetAddress)
+	_ = d.Set("internet_address", instance.InternetAddress)
+	_ = d.Set("create_time", instance.CreateTime)
+	if instance.InternetAddress != nil && *instance.InternetAddress != "" {
+		_ = d.Set("enable_public_network", true)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudTseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_instance.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	instanceId := d.Id()
+	client := meta.(*TencentCloudClient).apiV3Conn
+	tagService := TagService{client: client}
+	region := client.Region
+
+	d.Partial(true)
+
+	if d.HasChange("name") || d.HasChange("spec_id") || d.HasChange("replica") {
+		request := tse.NewModifyEngineRequest()
+		request.InstanceId = &instanceId
+		request.Name = helper.String(d.Get("name").(string))
+		request.EngineProductVersion = helper.String(d.Get("spec_id").(string))
+		request.EngineNodeNum = helper.IntUint64(d.Get("replica").(int))
+		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			_, e := client.UseTseClient().ModifyEngine(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s modify TSE instance failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+		d.SetPartial("name")
+		d.SetPartial("spec_id")
+		d.SetPartial("replica")
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+		resourceName := BuildTagResourceName("tse", "instance", region, instanceId)
+		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudTseInstanceRead(d, meta)
+}
+
+func resourceTencentCloudTseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_tse_instance.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	tseService := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := tseService.DeleteTseInstance(ctx, d.Id()); err != nil {
+		log.Printf("[CRITAL]%s delete TSE instance failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		instance, e := tseService.DescribeTseInstanceById(ctx, d.Id())
+		if e != nil {
+			return resource.NonRetryableError(e)
+		}
+		if instance != nil {
+			return resource.RetryableError(fmt.Errorf("TSE instance %s is still being deleted", d.Id()))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,103 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTencentCloudTseInstance_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTseInstance,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTseInstanceExists("tencentcloud_tse_instance.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_tse_instance.foo", "name", "terraform-test"),
+					resource.TestCheckResourceAttr("tencentcloud_tse_instance.foo", "engine_type", "nacos"),
+					resource.TestCheckResourceAttr("tencentcloud_tse_instance.foo", "engine_version", "2.0.3"),
+					resource.TestCheckResourceAttr("tencentcloud_tse_instance.foo", "replica", "3"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_tse_instance.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"engine_admin"},
+			},
+		},
+	})
+}
+
+func testAccCheckTseInstanceDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	tseService := TseService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_tse_instance" {
+			continue
+		}
+
+		instance, err := tseService.DescribeTseInstanceById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance != nil {
+			return fmt.Errorf("TSE instance still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTseInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource %s id is not set", n)
+		}
+
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		tseService := TseService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+
+		instance, err := tseService.DescribeTseInstanceById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if instance == nil {
+			return fmt.Errorf("TSE instance %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccTseInstance = `
+resource "tencentcloud_tse_instance" "foo" {
+  engine_type    = "nacos"
+  engine_version = "2.0.3"
+  spec_id        = "1C2G"
+  replica        = 3
+  name           = "terraform-test"
+  vpc_id         = "vpc-dk8zmwuf"
+  subnet_id      = "subnet-fzbrn2wf"
+
+  engine_admin {
+    name     = "admin"
+    password = "test12345789"
+  }
+}
+`
@@ -0,0 +1,496 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a VPN connection (tunnel).
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_connection" "my_vpn_conn" {
+  name                      = "test"
+  vpc_id                    = "vpc-dk8zmwuf"
+  vpn_gateway_id            = "vpngw-8ccsnclt"
+  customer_gateway_id       = "cgw-xfqag"
+  pre_share_key             = "test"
+
+  security_group_policy {
+    local_cidr_block  = "172.16.0.0/16"
+    remote_cidr_block = ["10.0.0.0/16"]
+  }
+
+  tags = {
+    test = "test"
+  }
+}
+```
+
+Import
+
+VPN connection can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpn_connection.foo vpnx-8ccsnclt
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpnConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnConnectionCreate,
+		Read:   resourceTencentCloudVpnConnectionRead,
+		Update: resourceTencentCloudVpnConnectionUpdate,
+		Delete: resourceTencentCloudVpnConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the VPN connection. The length of character is limited to 1-60.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPC.",
+			},
+			"vpn_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPN gateway.",
+			},
+			"customer_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the customer gateway.",
+			},
+			"pre_share_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Pre-shared key of the VPN connection.",
+			},
+			"routing_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      VPN_ROUTE_TYPE_STATIC,
+				ValidateFunc: validateAllowedStringValue([]string{VPN_ROUTE_TYPE_STATIC, VPN_ROUTE_TYPE_BGP}),
+				Description:  "Routing type of the VPN connection, valid values are `static`, `bgp`. Default is `static`. When `bgp`, `security_group_policy` is not required and the session parameters are advertised through `bgp_config` instead.",
+			},
+			"security_group_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Security group policy of the VPN connection. Only takes effect when `routing_type` is `static`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_cidr_block": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Local cidr block.",
+						},
+						"remote_cidr_block": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Remote cidr block list.",
+						},
+					},
+				},
+			},
+			"bgp_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "BGP session configuration. Only takes effect when `routing_type` is `bgp`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_asn": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Local BGP ASN.",
+						},
+						"remote_asn": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Remote (customer gateway) BGP ASN.",
+						},
+						"tunnel_cidr": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "CIDR of the tunnel interconnecting both BGP peers.",
+						},
+						"local_bgp_ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "BGP IP address on the VPN gateway side.",
+						},
+						"remote_bgp_ip": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateIpNotSpecialUse,
+							Description:  "BGP IP address on the customer gateway side.",
+						},
+						"bgp_hold_time": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     30,
+							Description: "BGP hold time in seconds. Default is 30.",
+						},
+					},
+				},
+			},
+			"bgp_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the BGP session, valid values are `AVAILABLE`, `NOTCONNECTED`. Only meaningful when `routing_type` is `bgp`.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the VPN connection, valid values are `PENDING`, `AVAILABLE`, `DELETING`.",
+			},
+			"net_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Net status of the VPN connection, valid values are `AVAILABLE`, `UNAVAILABLE`.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A list of tags used to associate different resources.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the VPN connection.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	request := vpc.NewCreateVpnConnectionRequest()
+	request.VpnConnectionName = helper.String(d.Get("name").(string))
+	request.VpcId = helper.String(d.Get("vpc_id").(string))
+	request.VpnGatewayId = helper.String(d.Get("vpn_gateway_id").(string))
+	request.CustomerGatewayId = helper.String(d.Get("customer_gateway_id").(string))
+	request.PreShareKey = helper.String(d.Get("pre_share_key").(string))
+
+	routingType := d.Get("routing_type").(string)
+	request.RouteType = helper.String(routingType)
+
+	if routingType == VPN_ROUTE_TYPE_BGP {
+		if v, ok := d.GetOk("bgp_config"); ok {
+			config := v.([]interface{})[0].(map[string]interface{})
+			request.BgpConfig = &vpc.BGPConfig{
+				LocalAsn:    helper.IntUint64(config["local_asn"].(int)),
+				RemoteAsn:   helper.IntUint64(config["remote_asn"].(int)),
+				TunnelCidr:  helper.String(config["tunnel_cidr"].(string)),
+				LocalBgpIp:  helper.String(config["local_bgp_ip"].(string)),
+				RemoteBgpIp: helper.String(config["remote_bgp_ip"].(string)),
+				BgpHoldTime: helper.IntUint64(config["bgp_hold_time"].(int)),
+			}
+		} else {
+			return fmt.Errorf("`bgp_config` is required when `routing_type` is `%s`", VPN_ROUTE_TYPE_BGP)
+		}
+	} else if v, ok := d.GetOk("security_group_policy"); ok {
+		policies := v.([]interface{})
+		request.SecurityPolicyDatabases = make([]*vpc.SecurityPolicyDatabase, 0, len(policies))
+		for _, item := range policies {
+			policy := item.(map[string]interface{})
+			remoteCidrs := make([]*string, 0)
+			for _, r := range policy["remote_cidr_block"].([]interface{}) {
+				remoteCidrs = append(remoteCidrs, helper.String(r.(string)))
+			}
+			request.SecurityPolicyDatabases = append(request.SecurityPolicyDatabases, &vpc.SecurityPolicyDatabase{
+				LocalCidrBlock:  helper.String(policy["local_cidr_block"].(string)),
+				RemoteCidrBlock: remoteCidrs,
+			})
+		}
+	}
+
+	var response *vpc.CreateVpnConnectionResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnConnection(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN connection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response.Response.VpnConnection == nil {
+		return fmt.Errorf("VPN connection id is nil")
+	}
+	connectionId := *response.Response.VpnConnection.VpnConnectionId
+	d.SetId(connectionId)
+
+	// must wait for creating connection finished
+	statRequest := vpc.NewDescribeVpnConnectionsRequest()
+	statRequest.VpnConnectionIds = []*string{helper.String(connectionId)}
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(statRequest)
+		if e != nil {
+			return retryError(e)
+		}
+		if len(result.Response.VpnConnectionSet) != 1 {
+			return resource.NonRetryableError(fmt.Errorf("creating error"))
+		}
+		if *result.Response.VpnConnectionSet[0].State == VPN_STATE_AVAILABLE {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("State is not available: %s, wait for state to be AVAILABLE.", *result.Response.VpnConnectionSet[0].State))
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN connection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//modify tags
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+		region := meta.(*TencentCloudClient).apiV3Conn.Region
+		resourceName := BuildTagResourceName("vpc", "vpnx", region, connectionId)
+
+		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudVpnConnectionRead(d, meta)
+}
+
+func resourceTencentCloudVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	connectionId := d.Id()
+	request := vpc.NewDescribeVpnConnectionsRequest()
+	request.VpnConnectionIds = []*string{&connectionId}
+	var response *vpc.DescribeVpnConnectionsResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == VPCNotFound || ee.Code == "ResourceNotFound" {
+				log.Printf("[CRITAL]%s api[%s] success, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN connection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the connection has been deleted out-of-band, recreate it on next apply
+	if response == nil || len(response.Response.VpnConnectionSet) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	connection := response.Response.VpnConnectionSet[0]
+
+	_ = d.Set("name", *connection.VpnConnectionName)
+	_ = d.Set("vpc_id", *connection.VpcId)
+	_ = d.Set("vpn_gateway_id", *connection.VpnGatewayId)
+	_ = d.Set("customer_gateway_id", *connection.CustomerGatewayId)
+	_ = d.Set("pre_share_key", *connection.PreShareKey)
+	_ = d.Set("state", *connection.State)
+	_ = d.Set("net_status", *connection.NetStatus)
+	_ = d.Set("create_time", *connection.CreateTime)
+
+	policies := make([]map[string]interface{}, 0, len(connection.SecurityPolicyDatabaseSet))
+	for _, item := range connection.SecurityPolicyDatabaseSet {
+		remoteCidrs := make([]string, 0, len(item.RemoteCidrBlock))
+		for _, r := range item.RemoteCidrBlock {
+			remoteCidrs = append(remoteCidrs, *r)
+		}
+		policies = append(policies, map[string]interface{}{
+			"local_cidr_block":  *item.LocalCidrBlock,
+			"remote_cidr_block": remoteCidrs,
+		})
+	}
+	_ = d.Set("security_group_policy", policies)
+
+	if connection.RouteType != nil {
+		_ = d.Set("routing_type", *connection.RouteType)
+	}
+	if connection.BgpConfig != nil {
+		bgpConfig := map[string]interface{}{
+			"local_asn":     int(*connection.BgpConfig.LocalAsn),
+			"remote_asn":    int(*connection.BgpConfig.RemoteAsn),
+			"tunnel_cidr":   *connection.BgpConfig.TunnelCidr,
+			"local_bgp_ip":  *connection.BgpConfig.LocalBgpIp,
+			"remote_bgp_ip": *connection.BgpConfig.RemoteBgpIp,
+		}
+		if connection.BgpConfig.BgpHoldTime != nil {
+			bgpConfig["bgp_hold_time"] = int(*connection.BgpConfig.BgpHoldTime)
+		}
+		_ = d.Set("bgp_config", []map[string]interface{}{bgpConfig})
+	}
+	if connection.BgpStatus != nil {
+		_ = d.Set("bgp_status", *connection.BgpStatus)
+	}
+
+	//tags
+	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
+	region := meta.(*TencentCloudClient).apiV3Conn.Region
+	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "vpnx", region, connectionId)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	d.Partial(true)
+	connectionId := d.Id()
+
+	if d.HasChange("name") || d.HasChange("pre_share_key") || d.HasChange("security_group_policy") {
+		request := vpc.NewModifyVpnConnectionAttributeRequest()
+		request.VpnConnectionId = &connectionId
+		request.VpnConnectionName = helper.String(d.Get("name").(string))
+		request.PreShareKey = helper.String(d.Get("pre_share_key").(string))
+		if v, ok := d.GetOk("security_group_policy"); ok {
+			policies := v.([]interface{})
+			request.SecurityPolicyDatabases = make([]*vpc.SecurityPolicyDatabase, 0, len(policies))
+			for _, item := range policies {
+				policy := item.(map[string]interface{})
+				remoteCidrs := make([]*string, 0)
+				for _, r := range policy["remote_cidr_block"].([]interface{}) {
+					remoteCidrs = append(remoteCidrs, helper.String(r.(string)))
+				}
+				request.SecurityPolicyDatabases = append(request.SecurityPolicyDatabases, &vpc.SecurityPolicyDatabase{
+					LocalCidrBlock:  helper.String(policy["local_cidr_block"].(string)),
+					RemoteCidrBlock: remoteCidrs,
+				})
+			}
+		}
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnConnectionAttribute(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s modify VPN connection failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+		d.SetPartial("name")
+		d.SetPartial("pre_share_key")
+		d.SetPartial("security_group_policy")
+	}
+
+	if d.HasChange("tags") {
+		oldInterface, newInterface := d.GetChange("tags")
+		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
+		tagService := TagService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		region := meta.(*TencentCloudClient).apiV3Conn.Region
+		resourceName := BuildTagResourceName("vpc", "vpnx", region, connectionId)
+		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudVpnConnectionRead(d, meta)
+}
+
+func resourceTencentCloudVpnConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection.delete")()
+
+	logId := getLogId(contextNil)
+
+	connectionId := d.Id()
+	request := vpc.NewDeleteVpnConnectionRequest()
+	request.VpnConnectionId = &connectionId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnConnection(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && (ee.Code == VPCNotFound || ee.Code == "ResourceNotFound") {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete VPN connection failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a static route for a VPN connection.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_connection_route" "route" {
+  vpn_connection_id      = tencentcloud_vpn_connection.my_vpn_conn.id
+  destination_cidr_block = "10.0.0.0/16"
+}
+```
+
+Import
+
+VPN connection route can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpn_connection_route.route vpnx-8ccsnclt#10.0.0.0/16
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpnConnectionRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnConnectionRouteCreate,
+		Read:   resourceTencentCloudVpnConnectionRouteRead,
+		Delete: resourceTencentCloudVpnConnectionRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPN connection that this route belongs to.",
+			},
+			"destination_cidr_block": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Destination CIDR block advertised by this static route.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the route, valid values are `AVAILABLE`.",
+			},
+		},
+	}
+}
+
+func vpnConnectionRouteId(connectionId, cidr string) string {
+	return connectionId + FILED_SP + cidr
+}
+
+func parseVpnConnectionRouteId(id string) (connectionId, cidr string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid VPN connection route id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection_route.create")()
+
+	logId := getLogId(contextNil)
+
+	connectionId := d.Get("vpn_connection_id").(string)
+	cidr := d.Get("destination_cidr_block").(string)
+
+	request := vpc.NewCreateVpnConnectionRouteRequest()
+	request.VpnConnectionId = &connectionId
+	request.DestinationCidrBlock = helper.String(cidr)
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnConnectionRoute(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN connection route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(vpnConnectionRouteId(connectionId, cidr))
+
+	return resourceTencentCloudVpnConnectionRouteRead(d, meta)
+}
+
+func resourceTencentCloudVpnConnectionRouteRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection_route.read")()
+
+	logId := getLogId(contextNil)
+
+	connectionId, cidr, err := parseVpnConnectionRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDescribeVpnConnectionRoutesRequest()
+	request.VpnConnectionId = &connectionId
+	var response *vpc.DescribeVpnConnectionRoutesResponse
+	err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionRoutes(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == VPCNotFound || ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN connection route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil {
+		d.SetId("")
+		return nil
+	}
+
+	for _, route := range response.Response.RouteSet {
+		if *route.DestinationCidrBlock == cidr {
+			_ = d.Set("vpn_connection_id", connectionId)
+			_ = d.Set("destination_cidr_block", *route.DestinationCidrBlock)
+			_ = d.Set("state", *route.Status)
+			return nil
+		}
+	}
+
+	//the route has been deleted out-of-band, recreate it on next apply
+	d.SetId("")
+	return nil
+}
+
+func resourceTencentCloudVpnConnectionRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_connection_route.delete")()
+
+	logId := getLogId(contextNil)
+
+	connectionId, cidr, err := parseVpnConnectionRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDeleteVpnConnectionRouteRequest()
+	request.VpnConnectionId = &connectionId
+	request.DestinationCidrBlock = helper.String(cidr)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnConnectionRoute(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && (ee.Code == VPCNotFound || ee.Code == "ResourceNotFound") {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete VPN connection route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+)
+
+func TestAccTencentCloudVpnConnectionRoute_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnConnectionRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnectionRouteConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnConnectionRouteExists("tencentcloud_vpn_connection_route.route"),
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection_route.route", "destination_cidr_block", "10.0.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_vpn_connection_route.route",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVpnConnectionRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*TencentCloudClient).apiV3Conn
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_vpn_connection_route" {
+			continue
+		}
+		connectionId, cidr, err := parseVpnConnectionRouteId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		request := vpc.NewDescribeVpnConnectionRoutesRequest()
+		request.VpnConnectionId = &connectionId
+		var response *vpc.DescribeVpnConnectionRoutesResponse
+		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := conn.UseVpcClient().DescribeVpnConnectionRoutes(request)
+			if e != nil {
+				return retryError(e)
+			}
+			response = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, route := range response.Response.RouteSet {
+			if *route.DestinationCidrBlock == cidr {
+				return fmt.Errorf("VPN connection route still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckVpnConnectionRouteExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN connection route instance %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("VPN connection route id is not set")
+		}
+		connectionId, cidr, err := parseVpnConnectionRouteId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		conn := testAccProvider.Meta().(*TencentCloudClient).apiV3Conn
+		request := vpc.NewDescribeVpnConnectionRoutesRequest()
+		request.VpnConnectionId = &connectionId
+		var response *vpc.DescribeVpnConnectionRoutesResponse
+		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, e := conn.UseVpcClient().DescribeVpnConnectionRoutes(request)
+			if e != nil {
+				return retryError(e)
+			}
+			response = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, route := range response.Response.RouteSet {
+			if *route.DestinationCidrBlock == cidr {
+				return nil
+			}
+		}
+		return fmt.Errorf("VPN connection route is not found")
+	}
+}
+
+const testAccVpnConnectionRouteConfig = testAccVpnConnectionConfig + `
+resource "tencentcloud_vpn_connection_route" "route" {
+  vpn_connection_id      = tencentcloud_vpn_connection.my_vpn_conn.id
+  destination_cidr_block = "10.0.0.0/16"
+}
+`
@@ -0,0 +1,86 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccTencentCloudVpnConnection_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn", "name", "terraform_test"),
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn", "pre_share_key", "test"),
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn", "routing_type", "static"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_vpn_connection.my_vpn_conn",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_share_key"},
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudVpnConnection_bgp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnectionConfigBgp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn_bgp", "name", "terraform_test_bgp"),
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn_bgp", "routing_type", "bgp"),
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn_bgp", "bgp_config.0.local_asn", "45050"),
+					resource.TestCheckResourceAttr("tencentcloud_vpn_connection.my_vpn_conn_bgp", "bgp_config.0.remote_asn", "65000"),
+					resource.TestCheckResourceAttrSet("tencentcloud_vpn_connection.my_vpn_conn_bgp", "bgp_status"),
+				),
+			},
+			{
+				ResourceName:            "tencentcloud_vpn_connection.my_vpn_conn_bgp",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_share_key"},
+			},
+		},
+	})
+}
+
+const testAccVpnConnectionConfigBgp = `
+resource "tencentcloud_vpn_customer_gateway" "my_cgw_bgp" {
+  name              = "terraform_test_bgp"
+  public_ip_address = "1.1.1.3"
+}
+
+resource "tencentcloud_vpn_gateway" "my_gw_bgp" {
+  name      = "terraform_test_bgp"
+  vpc_id    = "vpc-dk8zmwuf"
+  bandwidth = 5
+  zone      = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_vpn_connection" "my_vpn_conn_bgp" {
+  name                = "terraform_test_bgp"
+  vpc_id              = "vpc-dk8zmwuf"
+  vpn_gateway_id      = tencentcloud_vpn_gateway.my_gw_bgp.id
+  customer_gateway_id = tencentcloud_vpn_customer_gateway.my_cgw_bgp.id
+  pre_share_key       = "test"
+  routing_type        = "bgp"
+
+  bgp_config {
+    local_asn     = 45050
+    remote_asn    = 65000
+    tunnel_cidr   = "169.254.128.0/30"
+    local_bgp_ip  = "169.254.128.1"
+    remote_bgp_ip = "169.254.128.2"
+  }
+}
+`
@@ -0,0 +1,269 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a VPN customer gateway.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_customer_gateway" "my_cgw" {
+  name              = "test"
+  public_ip_address = "1.1.1.1"
+
+  tags = {
+    test = "test"
+  }
+}
+```
+
+Import
+
+VPN customer gateway can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpn_customer_gateway.foo cgw-xfqag
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpnCustomerGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnCustomerGatewayCreate,
+		Read:   resourceTencentCloudVpnCustomerGatewayRead,
+		Update: resourceTencentCloudVpnCustomerGatewayUpdate,
+		Delete: resourceTencentCloudVpnCustomerGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the VPN customer gateway. The length of character is limited to 1-60.",
+			},
+			"public_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIpNotSpecialUse,
+				Description:  "Public IP of the VPN customer gateway.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A list of tags used to associate different resources.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the VPN customer gateway.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpnCustomerGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_customer_gateway.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	request := vpc.NewCreateCustomerGatewayRequest()
+	request.CustomerGatewayName = helper.String(d.Get("name").(string))
+	request.IpAddress = helper.String(d.Get("public_ip_address").(string))
+
+	var response *vpc.CreateCustomerGatewayResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateCustomerGateway(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN customer gateway failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response.Response.CustomerGateway == nil {
+		return fmt.Errorf("VPN customer gateway id is nil")
+	}
+	customerGatewayId := *response.Response.CustomerGateway.CustomerGatewayId
+	d.SetId(customerGatewayId)
+
+	//modify tags
+	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
+		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+		region := meta.(*TencentCloudClient).apiV3Conn.Region
+		resourceName := BuildTagResourceName("vpc", "cgw", region, customerGatewayId)
+
+		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudVpnCustomerGatewayRead(d, meta)
+}
+
+func resourceTencentCloudVpnCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_customer_gateway.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	customerGatewayId := d.Id()
+	request := vpc.NewDescribeCustomerGatewaysRequest()
+	request.CustomerGatewayIds = []*string{&customerGatewayId}
+	var response *vpc.DescribeCustomerGatewaysResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeCustomerGateways(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == VPCNotFound || ee.Code == "ResourceNotFound" {
+				log.Printf("[CRITAL]%s api[%s] success, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return nil
+			} else {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN customer gateway failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	//the customer gateway has been deleted out-of-band, recreate it on next apply
+	if response == nil || len(response.Response.CustomerGatewaySet) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	gateway := response.Response.CustomerGatewaySet[0]
+
+	_ = d.Set("name", *gateway.CustomerGatewayName)
+	_ = d.Set("public_ip_address", *gateway.IpAddress)
+	_ = d.Set("create_time", *gateway.CreatedTime)
+
+	//tags
+	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
+	region := meta.(*TencentCloudClient).apiV3Conn.Region
+	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "cgw", region, customerGatewayId)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceTencentCloudVpnCustomerGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_customer_gateway.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), "logId", logId)
+
+	d.Partial(true)
+	customerGatewayId := d.Id()
+
+	if d.HasChange("name") {
+		request := vpc.NewModifyCustomerGatewayAttributeRequest()
+		request.CustomerGatewayId = &customerGatewayId
+		request.CustomerGatewayName = helper.String(d.Get("name").(string))
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyCustomerGatewayAttribute(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s modify VPN customer gateway name failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("tags") {
+		oldInterface, newInterface := d.GetChange("tags")
+		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
+		tagService := TagService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		region := meta.(*TencentCloudClient).apiV3Conn.Region
+		resourceName := BuildTagResourceName("vpc", "cgw", region, customerGatewayId)
+		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
+		if err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudVpnCustomerGatewayRead(d, meta)
+}
+
+func resourceTencentCloudVpnCustomerGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_customer_gateway.delete")()
+
+	logId := getLogId(contextNil)
+
+	customerGatewayId := d.Id()
+	request := vpc.NewDeleteCustomerGatewayRequest()
+	request.CustomerGatewayId = &customerGatewayId
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteCustomerGateway(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && (ee.Code == VPCNotFound || ee.Code == "ResourceNotFound") {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete VPN customer gateway failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -34,6 +34,11 @@ func TestAccTencentCloudVpnCustomerGateway_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_vpn_customer_gateway.my_cgw", "public_ip_address", "1.1.1.2"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_vpn_customer_gateway.my_cgw",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,153 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+)
+
+// testAccCheckVpnCustomerGatewayDisappears deletes the customer gateway directly
+// through the SDK so the next plan is expected to show a non-empty diff.
+func testAccCheckVpnCustomerGatewayDisappears(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN customer gateway instance %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("VPN customer gateway id is not set")
+		}
+		conn := testAccProvider.Meta().(*TencentCloudClient).apiV3Conn
+		request := vpc.NewDeleteCustomerGatewayRequest()
+		request.CustomerGatewayId = &rs.Primary.ID
+		_, err := conn.UseVpcClient().DeleteCustomerGateway(request)
+		return err
+	}
+}
+
+func TestAccTencentCloudVpnCustomerGateway_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpnCustomerGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnCustomerGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnCustomerGatewayExists("tencentcloud_vpn_customer_gateway.my_cgw"),
+					testAccCheckVpnCustomerGatewayDisappears("tencentcloud_vpn_customer_gateway.my_cgw"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckVpnGatewayDisappears deletes the VPN gateway directly through the
+// SDK so the next plan is expected to show a non-empty diff.
+func testAccCheckVpnGatewayDisappears(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN gateway instance %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("VPN gateway id is not set")
+		}
+		conn := testAccProvider.Meta().(*TencentCloudClient).apiV3Conn
+		request := vpc.NewDeleteVpnGatewayRequest()
+		request.VpnGatewayId = &rs.Primary.ID
+		_, err := conn.UseVpcClient().DeleteVpnGateway(request)
+		return err
+	}
+}
+
+func TestAccTencentCloudVpnGateway_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnGatewayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnGatewayDisappears("tencentcloud_vpn_gateway.my_cgw"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckVpnConnectionDisappears deletes the VPN connection directly through
+// the SDK so the next plan is expected to show a non-empty diff.
+func testAccCheckVpnConnectionDisappears(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN connection instance %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("VPN connection id is not set")
+		}
+		conn := testAccProvider.Meta().(*TencentCloudClient).apiV3Conn
+		request := vpc.NewDeleteVpnConnectionRequest()
+		request.VpnConnectionId = &rs.Primary.ID
+		_, err := conn.UseVpcClient().DeleteVpnConnection(request)
+		return err
+	}
+}
+
+func TestAccTencentCloudVpnConnection_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnConnectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpnConnectionDisappears("tencentcloud_vpn_connection.my_vpn_conn"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+const testAccVpnGatewayConfig = `
+resource "tencentcloud_vpn_gateway" "my_cgw" {
+  name      = "terraform_test"
+  vpc_id    = "vpc-dk8zmwuf"
+  bandwidth = 5
+  zone      = "ap-guangzhou-3"
+}
+`
+
+const testAccVpnConnectionConfig = `
+resource "tencentcloud_vpn_customer_gateway" "my_cgw" {
+  name              = "terraform_test"
+  public_ip_address = "1.1.1.2"
+}
+
+resource "tencentcloud_vpn_gateway" "my_gw" {
+  name      = "terraform_test"
+  vpc_id    = "vpc-dk8zmwuf"
+  bandwidth = 5
+  zone      = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_vpn_connection" "my_vpn_conn" {
+  name                = "terraform_test"
+  vpc_id              = "vpc-dk8zmwuf"
+  vpn_gateway_id      = tencentcloud_vpn_gateway.my_gw.id
+  customer_gateway_id = tencentcloud_vpn_customer_gateway.my_cgw.id
+  pre_share_key       = "test"
+
+  security_group_policy {
+    local_cidr_block  = "172.16.0.0/16"
+    remote_cidr_block = ["10.0.0.0/16"]
+  }
+}
+`
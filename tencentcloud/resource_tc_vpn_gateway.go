@@ -60,6 +60,16 @@ import (
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+const (
+	VPN_GATEWAY_TYPE_IPSEC = "IPSEC"
+	VPN_GATEWAY_TYPE_SSL   = "SSL"
+)
+
+const (
+	VPN_GATEWAY_ROUTE_TYPE_STATIC = "STATIC"
+	VPN_GATEWAY_ROUTE_TYPE_BGP    = "BGP"
+)
+
 func resourceTencentCloudVpnGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudVpnGatewayCreate,
@@ -69,6 +79,12 @@ func resourceTencentCloudVpnGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -96,9 +112,12 @@ func resourceTencentCloudVpnGateway() *schema.Resource {
 				Description: "Public ip of the VPN gateway.",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Type of gateway instance, valid values are `IPSEC`, `SSL`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      VPN_GATEWAY_TYPE_IPSEC,
+				ValidateFunc: validateAllowedStringValue([]string{VPN_GATEWAY_TYPE_IPSEC, VPN_GATEWAY_TYPE_SSL}),
+				Description:  "Type of gateway instance, valid values are `IPSEC`, `SSL`. Default is `IPSEC`. This para can only be set to take effect in create operation.",
 			},
 			"state": {
 				Type:        schema.TypeString,
@@ -150,6 +169,45 @@ func resourceTencentCloudVpnGateway() *schema.Resource {
 				ForceNew:    true,
 				Description: "Zone of the VPN gateway.",
 			},
+			"route_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      VPN_GATEWAY_ROUTE_TYPE_STATIC,
+				ValidateFunc: validateAllowedStringValue([]string{VPN_GATEWAY_ROUTE_TYPE_STATIC, VPN_GATEWAY_ROUTE_TYPE_BGP}),
+				Description:  "Routing type of the VPN gateway, valid values are `STATIC`, `BGP`. Default is `STATIC`. This para can only be set to take effect in create operation.",
+			},
+			"asn": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local BGP ASN of the VPN gateway. Only takes effect when `route_type` is `BGP`.",
+			},
+			"bgp_local_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Local BGP IP of the VPN gateway. Only available when `route_type` is `BGP`.",
+			},
+			"bgp_peer_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIpNotSpecialUse,
+				Description:  "Peer BGP IP of the VPN gateway. Only takes effect when `route_type` is `BGP`.",
+			},
+			"bgp_hold_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     30,
+				Description: "BGP hold time in seconds. Only takes effect when `route_type` is `BGP`. Default is 30.",
+			},
+			"wait_for_expire": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When the gateway is `PREPAID` and not yet expired, setting this to `true` makes delete wait for the gateway to reach its `expired_time` instead of failing immediately. Has no effect on `POSTPAID_BY_HOUR` gateways.",
+			},
 			"tags": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -177,6 +235,18 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	request.InternetMaxBandwidthOut = &bandwidth64
 	request.Zone = helper.String(d.Get("zone").(string))
 	request.VpcId = helper.String(d.Get("vpc_id").(string))
+	request.Type = helper.String(d.Get("type").(string))
+	routeType := d.Get("route_type").(string)
+	request.RouteType = &routeType
+	if routeType == VPN_GATEWAY_ROUTE_TYPE_BGP {
+		if asn, ok := d.GetOk("asn"); ok {
+			request.Asn = helper.IntUint64(asn.(int))
+		}
+		if peerIp, ok := d.GetOk("bgp_peer_ip"); ok {
+			request.BgpPeerIp = helper.String(peerIp.(string))
+		}
+		request.BgpHoldTime = helper.IntUint64(d.Get("bgp_hold_time").(int))
+	}
 	chargeType := d.Get("charge_type").(string)
 	//only support change renew_flag when charge type is pre-paid
 	if chargeType == VPN_CHARGE_TYPE_PREPAID {
@@ -187,7 +257,7 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	}
 	request.InstanceChargeType = &chargeType
 	var response *vpc.CreateVpnGatewayResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnGateway(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -211,7 +281,7 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	// must wait for creating gateway finished
 	statRequest := vpc.NewDescribeVpnGatewaysRequest()
 	statRequest.VpnGatewayIds = []*string{helper.String(gatewayId)}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -260,7 +330,7 @@ func resourceTencentCloudVpnGatewayRead(d *schema.ResourceData, meta interface{}
 	request := vpc.NewDescribeVpnGatewaysRequest()
 	request.VpnGatewayIds = []*string{&gatewayId}
 	var response *vpc.DescribeVpnGatewaysResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(request)
 		if e != nil {
 			ee, ok := e.(*errors.TceCloudSDKError)
@@ -284,7 +354,7 @@ func resourceTencentCloudVpnGatewayRead(d *schema.ResourceData, meta interface{}
 		log.Printf("[CRITAL]%s read VPN gateway failed, reason:%s\n", logId, err.Error())
 		return err
 	}
-	if len(response.Response.VpnGatewaySet) < 1 {
+	if response == nil || len(response.Response.VpnGatewaySet) < 1 {
 		d.SetId("")
 		return nil
 	}
@@ -304,6 +374,21 @@ func resourceTencentCloudVpnGatewayRead(d *schema.ResourceData, meta interface{}
 	_ = d.Set("new_purchase_plan", *gateway.NewPurchasePlan)
 	_ = d.Set("restrict_state", *gateway.RestrictState)
 	_ = d.Set("zone", *gateway.Zone)
+	if gateway.RouteType != nil {
+		_ = d.Set("route_type", *gateway.RouteType)
+	}
+	if gateway.Asn != nil {
+		_ = d.Set("asn", int(*gateway.Asn))
+	}
+	if gateway.BgpLocalIp != nil {
+		_ = d.Set("bgp_local_ip", *gateway.BgpLocalIp)
+	}
+	if gateway.BgpPeerIp != nil {
+		_ = d.Set("bgp_peer_ip", *gateway.BgpPeerIp)
+	}
+	if gateway.BgpHoldTime != nil {
+		_ = d.Set("bgp_hold_time", int(*gateway.BgpHoldTime))
+	}
 
 	//tags
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -328,7 +413,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 
 	//renew
 	if d.HasChange("prepaid_period") || d.HasChange("prepaid_renew_flag") {
-		return fmt.Errorf("Do not support renew operation in update operation. Please renew the instance on controller web page.")
+		return fmt.Errorf("Do not support renew operation in update operation. Use the tencentcloud_vpn_gateway_renewal resource instead.")
 	}
 
 	if d.HasChange("name") || d.HasChange("charge_type") {
@@ -345,7 +430,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		} else if oldChargeType == VPN_CHARGE_TYPE_POSTPAID_BY_HOUR && newChargeType == VPN_CHARGE_TYPE_PREPAID {
 			return fmt.Errorf("Invalid charge type change. Only support pre-paid to post-paid way.")
 		}
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewayAttribute(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -373,7 +458,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		bandwidth := d.Get("bandwidth").(int)
 		bandwidth64 := uint64(bandwidth)
 		request.InternetMaxBandwidthOut = &bandwidth64
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetVpnGatewayInternetMaxBandwidth(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -416,13 +501,14 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	logId := getLogId(contextNil)
 
 	gatewayId := d.Id()
+	waitForExpire := d.Get("wait_for_expire").(bool)
 
-	//prepaid instances can not be deleted
-	//to get expire_time of the VPN gateway
-	//to get the status of gateway
+	//prepaid instances can not be deleted before they expire, unless
+	//wait_for_expire is set, in which case we poll until expiry instead
+	//of failing immediately
 	chargeRequest := vpc.NewDescribeVpnGatewaysRequest()
 	chargeRequest.VpnGatewayIds = []*string{&gatewayId}
-	chargeErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	chargeErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(chargeRequest)
 		if e != nil {
 			return retryError(e)
@@ -439,6 +525,9 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 						return resource.NonRetryableError(fmt.Errorf("Error format expired time.%x %s", expiredTime, err))
 					}
 					if time.Until(t) > 0 {
+						if waitForExpire {
+							return resource.RetryableError(fmt.Errorf("VPN gateway has not expired yet, waiting until %s.", expiredTime))
+						}
 						return resource.NonRetryableError(fmt.Errorf("Delete operation is unsupport when VPN gateway is not expired."))
 					}
 					return nil
@@ -473,7 +562,7 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	offset := uint64(0)
 	tRequest.Offset = &offset
 
-	tErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	tErr := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(tRequest)
 
 		if e != nil {
@@ -496,7 +585,7 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	request := vpc.NewDeleteVpnGatewayRequest()
 	request.VpnGatewayId = &gatewayId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnGateway(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -512,7 +601,7 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	//to get the status of gateway
 	statRequest := vpc.NewDescribeVpnGatewaysRequest()
 	statRequest.VpnGatewayIds = []*string{&gatewayId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(statRequest)
 		if e != nil {
 			ee, ok := e.(*errors.TceCloudSDKError)
@@ -0,0 +1,227 @@
+// +build tencentcloud
+
+/*
+Provides a resource to manage the prepaid renewal lifecycle of a VPN
+gateway, independently from `tencentcloud_vpn_gateway` itself.
+
+`tencentcloud_vpn_gateway` refuses to change `prepaid_period` or
+`prepaid_renew_flag` after creation, and refuses to delete a `PREPAID`
+gateway before it expires. This resource gives Terraform a managed path
+for those two operations instead.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_gateway" "my_cgw" {
+  name            = "test"
+  vpc_id          = "vpc-dk8zmwuf"
+  bandwidth       = 5
+  zone            = "ap-guangzhou-3"
+  charge_type     = "PREPAID"
+  prepaid_period  = 1
+  wait_for_expire = true
+}
+
+resource "tencentcloud_vpn_gateway_renewal" "foo" {
+  vpn_gateway_id = tencentcloud_vpn_gateway.my_cgw.id
+  prepaid_period = 2
+  renew_flag     = "NOTIFY_AND_AUTO_RENEW"
+  on_destroy     = "disable_autorenew"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	VPN_GATEWAY_RENEWAL_ON_DESTROY_RELEASE_ON_EXPIRE = "release_on_expire"
+	VPN_GATEWAY_RENEWAL_ON_DESTROY_DISABLE_AUTORENEW = "disable_autorenew"
+)
+
+func resourceTencentCloudVpnGatewayRenewal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnGatewayRenewalCreate,
+		Read:   resourceTencentCloudVpnGatewayRenewalRead,
+		Update: resourceTencentCloudVpnGatewayRenewalUpdate,
+		Delete: resourceTencentCloudVpnGatewayRenewalDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the `PREPAID` VPN gateway this resource manages the renewal lifecycle for.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedIntValue([]int{1, 2, 3, 4, 6, 7, 8, 9, 12, 24, 36}),
+				Description:  "Number of months to extend the gateway by on create, and on every subsequent change to this value. Valid values are 1, 2, 3, 4, 6, 7, 8, 9, 12, 24, 36.",
+			},
+			"renew_flag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      VPN_PERIOD_PREPAID_RENEW_FLAG_AUTO_NOTIFY,
+				ValidateFunc: validateAllowedStringValue([]string{"NOTIFY_AND_RENEW", "NOTIFY_AND_AUTO_RENEW", "NOT_NOTIFY_AND_NOT_RENEW"}),
+				Description:  "Auto-renew flag of the VPN gateway, valid values are `NOTIFY_AND_RENEW`, `NOTIFY_AND_AUTO_RENEW`, `NOT_NOTIFY_AND_NOT_RENEW`. Default is `NOTIFY_AND_AUTO_RENEW`.",
+			},
+			"on_destroy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      VPN_GATEWAY_RENEWAL_ON_DESTROY_DISABLE_AUTORENEW,
+				ValidateFunc: validateAllowedStringValue([]string{VPN_GATEWAY_RENEWAL_ON_DESTROY_RELEASE_ON_EXPIRE, VPN_GATEWAY_RENEWAL_ON_DESTROY_DISABLE_AUTORENEW}),
+				Description:  "Behavior on destroy, valid values are `release_on_expire` (flip `renew_flag` to `NOT_NOTIFY_AND_NOT_RENEW` and return immediately, letting the gateway expire on its own) and `disable_autorenew` (same flag flip, alias kept for clarity). Default is `disable_autorenew`.",
+			},
+			"expired_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expired time of the VPN gateway after the most recent renewal.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpnGatewayRenewalCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_renewal.create")()
+
+	logId := getLogId(contextNil)
+	gatewayId := d.Get("vpn_gateway_id").(string)
+
+	if period, ok := d.GetOk("prepaid_period"); ok {
+		if err := renewVpnGateway(logId, d, meta, gatewayId, period.(int)); err != nil {
+			return err
+		}
+	}
+	if err := modifyVpnGatewayRenewFlag(logId, d, meta, gatewayId, d.Get("renew_flag").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(gatewayId)
+
+	return resourceTencentCloudVpnGatewayRenewalRead(d, meta)
+}
+
+func resourceTencentCloudVpnGatewayRenewalRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_renewal.read")()
+
+	logId := getLogId(contextNil)
+	gatewayId := d.Id()
+
+	request := vpc.NewDescribeVpnGatewaysRequest()
+	request.VpnGatewayIds = []*string{&gatewayId}
+	var response *vpc.DescribeVpnGatewaysResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN gateway renewal failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil || len(response.Response.VpnGatewaySet) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	gateway := response.Response.VpnGatewaySet[0]
+	_ = d.Set("vpn_gateway_id", gatewayId)
+	if gateway.RenewFlag != nil {
+		_ = d.Set("renew_flag", *gateway.RenewFlag)
+	}
+	if gateway.ExpiredTime != nil {
+		_ = d.Set("expired_time", *gateway.ExpiredTime)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudVpnGatewayRenewalUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_renewal.update")()
+
+	logId := getLogId(contextNil)
+	gatewayId := d.Id()
+
+	if d.HasChange("prepaid_period") {
+		if period, ok := d.GetOk("prepaid_period"); ok {
+			if err := renewVpnGateway(logId, d, meta, gatewayId, period.(int)); err != nil {
+				return err
+			}
+		}
+	}
+	if d.HasChange("renew_flag") {
+		if err := modifyVpnGatewayRenewFlag(logId, d, meta, gatewayId, d.Get("renew_flag").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudVpnGatewayRenewalRead(d, meta)
+}
+
+func resourceTencentCloudVpnGatewayRenewalDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_renewal.delete")()
+
+	logId := getLogId(contextNil)
+	gatewayId := d.Id()
+
+	//both on_destroy modes just stop auto-renewal and let the gateway run
+	//out its current prepaid period; neither one forces an immediate
+	//release, since tencentcloud_vpn_gateway's own delete already refuses
+	//to remove a PREPAID gateway before it expires (or waits for expiry
+	//when wait_for_expire is set)
+	return modifyVpnGatewayRenewFlag(logId, d, meta, gatewayId, "NOT_NOTIFY_AND_NOT_RENEW")
+}
+
+func renewVpnGateway(logId string, d *schema.ResourceData, meta interface{}, gatewayId string, period int) error {
+	request := vpc.NewRenewVpnGatewayRequest()
+	request.VpnGatewayId = &gatewayId
+	request.InstanceChargePrepaid = &vpc.InstanceChargePrepaid{
+		Period: helper.IntUint64(period),
+	}
+
+	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RenewVpnGateway(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+}
+
+func modifyVpnGatewayRenewFlag(logId string, d *schema.ResourceData, meta interface{}, gatewayId, renewFlag string) error {
+	request := vpc.NewModifyVpnGatewayRenewalPolicyRequest()
+	request.VpnGatewayId = &gatewayId
+	request.RenewFlag = &renewFlag
+
+	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewayRenewalPolicy(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,258 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a route on a route-based (BGP) VPN gateway.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_gateway" "bgp_gw" {
+  name        = "bgp-vpn-gw"
+  vpc_id      = "vpc-dk8zmwuf"
+  bandwidth   = 5
+  zone        = "ap-guangzhou-3"
+  route_type  = "BGP"
+  asn         = 65000
+  bgp_peer_ip = "8.8.4.4"
+}
+
+resource "tencentcloud_vpn_gateway_route" "foo" {
+  vpn_gateway_id          = tencentcloud_vpn_gateway.bgp_gw.id
+  destination_cidr_block  = "10.0.0.0/16"
+  instance_type           = "VPNCONN"
+  instance_id             = "vpnx-8ccsnclt"
+  priority                = 100
+}
+```
+
+Import
+
+VPN gateway route can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpn_gateway_route.foo vpngw-8ccsnclt#10.0.0.0/16
+```
+*/
+package tencentcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const (
+	VPN_GATEWAY_ROUTE_INSTANCE_TYPE_VPNCONN = "VPNCONN"
+	VPN_GATEWAY_ROUTE_INSTANCE_TYPE_CCN     = "CCN"
+)
+
+func resourceTencentCloudVpnGatewayRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnGatewayRouteCreate,
+		Read:   resourceTencentCloudVpnGatewayRouteRead,
+		Delete: resourceTencentCloudVpnGatewayRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the route-based VPN gateway this route belongs to.",
+			},
+			"destination_cidr_block": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Destination CIDR block of this route.",
+			},
+			"instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{VPN_GATEWAY_ROUTE_INSTANCE_TYPE_VPNCONN, VPN_GATEWAY_ROUTE_INSTANCE_TYPE_CCN}),
+				Description:  "Type of the next hop instance, valid values are `VPNCONN`, `CCN`.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the next hop instance.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     100,
+				Description: "Priority of the route, the smaller the value, the higher the priority. Default is 100.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the route, valid values are `AVAILABLE`, `DOWN`.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the route, valid values are `STATIC`, `BGP`.",
+			},
+		},
+	}
+}
+
+func vpnGatewayRouteId(gatewayId, cidr string) string {
+	return gatewayId + FILED_SP + cidr
+}
+
+func parseVpnGatewayRouteId(id string) (gatewayId, cidr string, err error) {
+	parts := strings.Split(id, FILED_SP)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid VPN gateway route id: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceTencentCloudVpnGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_route.create")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId := d.Get("vpn_gateway_id").(string)
+	cidr := d.Get("destination_cidr_block").(string)
+
+	request := vpc.NewCreateVpnGatewayRoutesRequest()
+	request.VpnGatewayId = &gatewayId
+	request.Routes = []*vpc.VpnGatewayRoute{
+		{
+			DestinationCidrBlock: helper.String(cidr),
+			InstanceType:         helper.String(d.Get("instance_type").(string)),
+			InstanceId:           helper.String(d.Get("instance_id").(string)),
+			Priority:             helper.IntUint64(d.Get("priority").(int)),
+		},
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnGatewayRoutes(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN gateway route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(vpnGatewayRouteId(gatewayId, cidr))
+
+	return resourceTencentCloudVpnGatewayRouteRead(d, meta)
+}
+
+func resourceTencentCloudVpnGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_route.read")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, cidr, err := parseVpnGatewayRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDescribeVpnGatewayRoutesRequest()
+	request.VpnGatewayId = &gatewayId
+	var response *vpc.DescribeVpnGatewayRoutesResponse
+	err = resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewayRoutes(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == VPCNotFound || ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN gateway route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil {
+		d.SetId("")
+		return nil
+	}
+
+	for _, route := range response.Response.VpnGatewayRouteSet {
+		if *route.DestinationCidrBlock == cidr {
+			_ = d.Set("vpn_gateway_id", gatewayId)
+			_ = d.Set("destination_cidr_block", *route.DestinationCidrBlock)
+			_ = d.Set("instance_type", *route.InstanceType)
+			_ = d.Set("instance_id", *route.InstanceId)
+			_ = d.Set("priority", int(*route.Priority))
+			_ = d.Set("status", *route.Status)
+			_ = d.Set("type", *route.Type)
+			return nil
+		}
+	}
+
+	//the route has been deleted out-of-band, recreate it on next apply
+	d.SetId("")
+	return nil
+}
+
+func resourceTencentCloudVpnGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_gateway_route.delete")()
+
+	logId := getLogId(contextNil)
+
+	gatewayId, cidr, err := parseVpnGatewayRouteId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := vpc.NewDeleteVpnGatewayRoutesRequest()
+	request.VpnGatewayId = &gatewayId
+	request.DestinationCidrBlocks = []*string{helper.String(cidr)}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnGatewayRoutes(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && (ee.Code == VPCNotFound || ee.Code == "ResourceNotFound") {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete VPN gateway route failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
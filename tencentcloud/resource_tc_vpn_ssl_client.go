@@ -0,0 +1,229 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create a per-user client certificate for an SSL VPN
+server, and exposes the generated OpenVPN client configuration.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_ssl_client" "foo" {
+  ssl_vpn_server_id  = tencentcloud_vpn_ssl_server.foo.id
+  name               = "alice"
+  result_output_file = "alice.ovpn"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpnSslClient() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnSslClientCreate,
+		Read:   resourceTencentCloudVpnSslClientRead,
+		Delete: resourceTencentCloudVpnSslClientDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ssl_vpn_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the SSL VPN server this client certificate is issued for.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name identifying this client/user. The length of character is limited to 1-60.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save the generated OpenVPN client configuration file.",
+			},
+
+			// Computed values
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the client certificate, valid values are `PENDING`, `AVAILABLE`, `DELETING`.",
+			},
+			"client_config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Generated OpenVPN client configuration (`.ovpn` file contents) for this client.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpnSslClientCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_client.create")()
+
+	logId := getLogId(contextNil)
+
+	request := vpc.NewCreateVpnGatewaySslClientCertRequest()
+	request.SslVpnServerId = helper.String(d.Get("ssl_vpn_server_id").(string))
+	request.SslVpnClientName = helper.String(d.Get("name").(string))
+
+	var response *vpc.CreateVpnGatewaySslClientCertResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnGatewaySslClientCert(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN SSL client cert failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(*response.Response.SslVpnClientCert.Id)
+
+	return resourceTencentCloudVpnSslClientRead(d, meta)
+}
+
+func resourceTencentCloudVpnSslClientRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_client.read")()
+
+	logId := getLogId(contextNil)
+
+	id := d.Id()
+	request := vpc.NewDescribeVpnGatewaySslClientCertsRequest()
+	request.SslVpnClientIds = []*string{&id}
+
+	var response *vpc.DescribeVpnGatewaySslClientCertsResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewaySslClientCerts(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN SSL client cert failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil || len(response.Response.SslVpnClientCertSet) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	cert := response.Response.SslVpnClientCertSet[0]
+	_ = d.Set("ssl_vpn_server_id", cert.SslVpnServerId)
+	_ = d.Set("name", cert.SslVpnClientName)
+	_ = d.Set("state", cert.State)
+
+	config, err := downloadVpnSslClientConfig(meta, id)
+	if err != nil {
+		log.Printf("[CRITAL]%s download VPN SSL client config failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+	_ = d.Set("client_config", config)
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceTencentCloudVpnSslClientDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_client.delete")()
+
+	logId := getLogId(contextNil)
+	id := d.Id()
+
+	request := vpc.NewDeleteVpnGatewaySslClientCertRequest()
+	request.SslVpnClientId = &id
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnGatewaySslClientCert(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete VPN SSL client cert failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// downloadVpnSslClientConfig fetches the generated .ovpn file contents for
+// the given client cert. The API returns a pre-signed, short-lived download
+// URL rather than the file body itself.
+func downloadVpnSslClientConfig(meta interface{}, sslVpnClientId string) (string, error) {
+	request := vpc.NewDownloadVpnGatewaySslClientCertRequest()
+	request.SslVpnClientId = &sslVpnClientId
+
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DownloadVpnGatewaySslClientCert(request)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Get(*response.Response.Url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body := make([]byte, 0)
+	buf := make([]byte, 4096)
+	for {
+		n, rerr := resp.Body.Read(buf)
+		if n > 0 {
+			body = append(body, buf[:n]...)
+		}
+		if rerr != nil {
+			break
+		}
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,285 @@
+// +build tencentcloud
+
+/*
+Provides a resource to create an SSL VPN server on an SSL-type VPN gateway.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpn_gateway" "ssl_gw" {
+  name      = "ssl-vpn-gw"
+  vpc_id    = "vpc-dk8zmwuf"
+  bandwidth = 5
+  zone      = "ap-guangzhou-3"
+  type      = "SSL"
+}
+
+resource "tencentcloud_vpn_ssl_server" "foo" {
+  vpn_gateway_id      = tencentcloud_vpn_gateway.ssl_gw.id
+  name                = "ssl-server"
+  local_address       = "172.16.0.0/16"
+  remote_address      = "172.17.0.0/16"
+  ssl_vpn_protocol    = "UDP"
+  ssl_vpn_port        = 1194
+  cipher              = "AES-128-CBC"
+  integrity_algorithm = "SHA1"
+  compress            = false
+}
+```
+
+Import
+
+VPN SSL server can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpn_ssl_server.foo vpnsslserver-kgj8e3cg
+```
+*/
+package tencentcloud
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	vpc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/vpc/v20170312"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpnSslServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudVpnSslServerCreate,
+		Read:   resourceTencentCloudVpnSslServerRead,
+		Update: resourceTencentCloudVpnSslServerUpdate,
+		Delete: resourceTencentCloudVpnSslServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the SSL-type VPN gateway this server belongs to.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the SSL VPN server. The length of character is limited to 1-60.",
+			},
+			"local_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIDR block on the VPN gateway side reachable through the SSL tunnel.",
+			},
+			"remote_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CIDR block the server assigns to connecting SSL VPN clients.",
+			},
+			"ssl_vpn_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "UDP",
+				ValidateFunc: validateAllowedStringValue([]string{"UDP", "TCP"}),
+				Description:  "Transport protocol used by the SSL tunnel, valid values are `UDP`, `TCP`. Default is `UDP`.",
+			},
+			"ssl_vpn_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     1194,
+				Description: "Port the SSL VPN server listens on. Default is 1194.",
+			},
+			"cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "AES-128-CBC",
+				ValidateFunc: validateAllowedStringValue([]string{"AES-128-CBC", "AES-192-CBC", "AES-256-CBC"}),
+				Description:  "Encryption algorithm used by the SSL tunnel. Default is `AES-128-CBC`.",
+			},
+			"integrity_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SHA1",
+				ValidateFunc: validateAllowedStringValue([]string{"SHA1", "MD5"}),
+				Description:  "Integrity algorithm used by the SSL tunnel. Default is `SHA1`.",
+			},
+			"compress": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to enable compression of the SSL tunnel payload.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the SSL VPN server, valid values are `PENDING`, `AVAILABLE`, `DELETING`.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpnSslServerCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_server.create")()
+
+	logId := getLogId(contextNil)
+
+	request := vpc.NewCreateVpnGatewaySslServerRequest()
+	request.VpnGatewayId = helper.String(d.Get("vpn_gateway_id").(string))
+	request.SslVpnServerName = helper.String(d.Get("name").(string))
+	request.LocalAddress = helper.String(d.Get("local_address").(string))
+	request.RemoteAddress = helper.String(d.Get("remote_address").(string))
+	request.SslVpnProtocol = helper.String(d.Get("ssl_vpn_protocol").(string))
+	request.SslVpnPort = helper.IntUint64(d.Get("ssl_vpn_port").(int))
+	request.IntegrityAlgorithm = helper.String(d.Get("integrity_algorithm").(string))
+	request.EncryptAlgorithm = helper.String(d.Get("cipher").(string))
+	request.Compress = helper.Bool(d.Get("compress").(bool))
+
+	var response *vpc.CreateVpnGatewaySslServerResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnGatewaySslServer(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create VPN SSL server failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	d.SetId(*response.Response.SslVpnServer.Id)
+
+	return resourceTencentCloudVpnSslServerRead(d, meta)
+}
+
+func resourceTencentCloudVpnSslServerRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_server.read")()
+
+	logId := getLogId(contextNil)
+
+	id := d.Id()
+	request := vpc.NewDescribeVpnGatewaySslServersRequest()
+	request.SslVpnServerIds = []*string{&id}
+
+	var response *vpc.DescribeVpnGatewaySslServersResponse
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewaySslServers(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if !ok {
+				return retryError(e)
+			}
+			if ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s read VPN SSL server failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	if response == nil || len(response.Response.SslVpnServerSet) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	server := response.Response.SslVpnServerSet[0]
+	_ = d.Set("vpn_gateway_id", server.VpnGatewayId)
+	_ = d.Set("name", server.SslVpnServerName)
+	_ = d.Set("local_address", server.LocalAddress)
+	_ = d.Set("remote_address", server.RemoteAddress)
+	_ = d.Set("ssl_vpn_protocol", server.SslVpnProtocol)
+	_ = d.Set("ssl_vpn_port", server.SslVpnPort)
+	_ = d.Set("cipher", server.EncryptAlgorithm)
+	_ = d.Set("integrity_algorithm", server.IntegrityAlgorithm)
+	_ = d.Set("compress", server.Compress)
+	_ = d.Set("state", server.State)
+
+	return nil
+}
+
+func resourceTencentCloudVpnSslServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_server.update")()
+
+	logId := getLogId(contextNil)
+	id := d.Id()
+
+	if d.HasChange("name") {
+		request := vpc.NewModifyVpnGatewaySslServerRequest()
+		request.SslVpnServerId = &id
+		request.SslVpnServerName = helper.String(d.Get("name").(string))
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewaySslServer(request)
+			if e != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), e.Error())
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s modify VPN SSL server name failed, reason:%s\n", logId, err.Error())
+			return err
+		}
+	}
+
+	return resourceTencentCloudVpnSslServerRead(d, meta)
+}
+
+func resourceTencentCloudVpnSslServerDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_vpn_ssl_server.delete")()
+
+	logId := getLogId(contextNil)
+	id := d.Id()
+
+	request := vpc.NewDeleteVpnGatewaySslServerRequest()
+	request.SslVpnServerId = &id
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnGatewaySslServer(request)
+		if e != nil {
+			ee, ok := e.(*errors.TceCloudSDKError)
+			if ok && ee.Code == "ResourceNotFound" {
+				return nil
+			}
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s delete VPN SSL server failed, reason:%s\n", logId, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,746 @@
+// +build tencentcloud
+
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apigateway "github.com/tencentyun/tcecloud-sdk-go/tcecloud/apigateway/v20180808"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+type ApiGatewayService struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (me *ApiGatewayService) CreateService(ctx context.Context, request *apigateway.CreateServiceRequest) (serviceId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().CreateService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.ServiceId == nil {
+		errRet = fmt.Errorf("API gateway api[%s] return service id is nil", request.GetAction())
+		return
+	}
+	serviceId = *response.Response.ServiceId
+	return
+}
+
+func (me *ApiGatewayService) DescribeServiceById(ctx context.Context, serviceId string) (service *apigateway.DescribeServiceResponseParams, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeServiceRequest()
+	request.ServiceId = &serviceId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeService(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	service = response.Response
+	return
+}
+
+func (me *ApiGatewayService) DescribeServices(ctx context.Context, serviceId, serviceName string) (services []*apigateway.ServiceStatus, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeServicesStatusRequest()
+	if serviceId != "" {
+		request.Filters = append(request.Filters, &apigateway.Filter{
+			Name:   helper.String("ServiceId"),
+			Values: []*string{&serviceId},
+		})
+	}
+	if serviceName != "" {
+		request.Filters = append(request.Filters, &apigateway.Filter{
+			Name:   helper.String("ServiceName"),
+			Values: []*string{&serviceName},
+		})
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeServicesStatus(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	services = response.Response.ServiceSet
+	return
+}
+
+func (me *ApiGatewayService) ModifyService(ctx context.Context, request *apigateway.ModifyServiceRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().ModifyService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DeleteService(ctx context.Context, serviceId string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewDeleteServiceRequest()
+	request.ServiceId = &serviceId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().DeleteService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) CreateApi(ctx context.Context, request *apigateway.CreateApiRequest) (apiId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().CreateApi(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.ApiId == nil {
+		errRet = fmt.Errorf("API gateway api[%s] return api id is nil", request.GetAction())
+		return
+	}
+	apiId = *response.Response.ApiId
+	return
+}
+
+func (me *ApiGatewayService) DescribeApiById(ctx context.Context, serviceId, apiId string) (api *apigateway.ApiInfo, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeApiRequest()
+	request.ServiceId = &serviceId
+	request.ApiId = &apiId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeApi(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	api = response.Response.Result
+	return
+}
+
+func (me *ApiGatewayService) ModifyApi(ctx context.Context, request *apigateway.ModifyApiRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().ModifyApi(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DeleteApi(ctx context.Context, serviceId, apiId string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewDeleteApiRequest()
+	request.ServiceId = &serviceId
+	request.ApiId = &apiId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().DeleteApi(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) CreateApiKey(ctx context.Context, request *apigateway.CreateApiKeyRequest) (accessKeyId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().CreateApiKey(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.Result == nil || response.Response.Result.AccessKeyId == nil {
+		errRet = fmt.Errorf("API gateway api[%s] return access key id is nil", request.GetAction())
+		return
+	}
+	accessKeyId = *response.Response.Result.AccessKeyId
+	return
+}
+
+func (me *ApiGatewayService) DescribeApiKeyById(ctx context.Context, accessKeyId string) (apiKey *apigateway.ApiKey, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeApiKeysStatusRequest()
+	request.Filters = []*apigateway.Filter{
+		{
+			Name:   helper.String("AccessKeyId"),
+			Values: []*string{&accessKeyId},
+		},
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeApiKeysStatus(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if len(response.Response.Result.ApiKeySet) < 1 {
+		return
+	}
+	apiKey = response.Response.Result.ApiKeySet[0]
+	return
+}
+
+func (me *ApiGatewayService) DescribeApiKeys(ctx context.Context, accessKeyId, secretName string) (apiKeys []*apigateway.ApiKey, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeApiKeysStatusRequest()
+	if accessKeyId != "" {
+		request.Filters = append(request.Filters, &apigateway.Filter{
+			Name:   helper.String("AccessKeyId"),
+			Values: []*string{&accessKeyId},
+		})
+	}
+	if secretName != "" {
+		request.Filters = append(request.Filters, &apigateway.Filter{
+			Name:   helper.String("SecretName"),
+			Values: []*string{&secretName},
+		})
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeApiKeysStatus(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	apiKeys = response.Response.Result.ApiKeySet
+	return
+}
+
+func (me *ApiGatewayService) ModifyApiKeyStatus(ctx context.Context, accessKeyId string, enable bool) error {
+	logId := getLogId(ctx)
+	if enable {
+		request := apigateway.NewEnableApiKeyRequest()
+		request.AccessKeyId = &accessKeyId
+		ratelimit.Check(request.GetAction())
+		if _, err := me.client.UseApiGatewayClient().EnableApiKey(request); err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+		return nil
+	}
+
+	request := apigateway.NewDisableApiKeyRequest()
+	request.AccessKeyId = &accessKeyId
+	ratelimit.Check(request.GetAction())
+	if _, err := me.client.UseApiGatewayClient().DisableApiKey(request); err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DeleteApiKey(ctx context.Context, accessKeyId string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewDeleteApiKeyRequest()
+	request.AccessKeyId = &accessKeyId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().DeleteApiKey(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) BindSecretIds(ctx context.Context, usagePlanId string, accessKeyIds []*string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewBindSecretIdsRequest()
+	request.UsagePlanId = &usagePlanId
+	request.AccessKeyIds = accessKeyIds
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().BindSecretIds(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) UnBindSecretIds(ctx context.Context, usagePlanId string, accessKeyIds []*string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewUnBindSecretIdsRequest()
+	request.UsagePlanId = &usagePlanId
+	request.AccessKeyIds = accessKeyIds
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().UnBindSecretIds(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DescribeApiKeysAttachedByUsagePlan(ctx context.Context, usagePlanId string) (accessKeyIds []string, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeUsagePlanSecretIdsRequest()
+	request.UsagePlanId = &usagePlanId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeUsagePlanSecretIds(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	for _, item := range response.Response.Result.ApiKeySet {
+		if item.AccessKeyId != nil {
+			accessKeyIds = append(accessKeyIds, *item.AccessKeyId)
+		}
+	}
+	return
+}
+
+func (me *ApiGatewayService) CreateCustomDomain(ctx context.Context, request *apigateway.CreateCustomDomainRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().CreateCustomDomain(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DescribeCustomDomainById(ctx context.Context, serviceId, subDomain string) (domain *apigateway.DomainSetList, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeServiceSubDomainsRequest()
+	request.ServiceId = &serviceId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeServiceSubDomains(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	for _, item := range response.Response.Result.DomainSet {
+		if item.DomainName != nil && *item.DomainName == subDomain {
+			domain = item
+			break
+		}
+	}
+	return
+}
+
+func (me *ApiGatewayService) ModifyCustomDomain(ctx context.Context, request *apigateway.ModifyCustomDomainRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().ModifyCustomDomain(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DeleteCustomDomain(ctx context.Context, serviceId, subDomain string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewDeleteCustomDomainRequest()
+	request.ServiceId = &serviceId
+	request.SubDomain = &subDomain
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().DeleteCustomDomain(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) CreateIPStrategy(ctx context.Context, request *apigateway.CreateIPStrategyRequest) (strategyId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().CreateIPStrategy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.Result == nil || response.Response.Result.StrategyId == nil {
+		errRet = fmt.Errorf("API gateway api[%s] return strategy id is nil", request.GetAction())
+		return
+	}
+	strategyId = *response.Response.Result.StrategyId
+	return
+}
+
+func (me *ApiGatewayService) DescribeIPStrategyById(ctx context.Context, serviceId, strategyId string) (strategy *apigateway.IPStrategy, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeIPStrategysStatusRequest()
+	request.ServiceId = &serviceId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeIPStrategysStatus(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	for _, item := range response.Response.Result.StrategySet {
+		if item.StrategyId != nil && *item.StrategyId == strategyId {
+			strategy = item
+			break
+		}
+	}
+	return
+}
+
+func (me *ApiGatewayService) ModifyIPStrategy(ctx context.Context, request *apigateway.ModifyIPStrategyRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().ModifyIPStrategy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DeleteIPStrategy(ctx context.Context, serviceId, strategyId string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewDeleteIPStrategyRequest()
+	request.ServiceId = &serviceId
+	request.StrategyId = &strategyId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().DeleteIPStrategy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) BindIPStrategy(ctx context.Context, serviceId, strategyId, environmentName string, apiIds []*string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewBindIPStrategyRequest()
+	request.ServiceId = &serviceId
+	request.StrategyId = &strategyId
+	request.EnvironmentName = &environmentName
+	request.BindApiIds = apiIds
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().BindIPStrategy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) UnBindIPStrategy(ctx context.Context, serviceId, strategyId, environmentName string, apiIds []*string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewUnBindIPStrategyRequest()
+	request.ServiceId = &serviceId
+	request.StrategyId = &strategyId
+	request.EnvironmentName = &environmentName
+	request.UnBindApiIds = apiIds
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().UnBindIPStrategy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) ReleaseService(ctx context.Context, serviceId, environmentName, releaseDesc string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewReleaseServiceRequest()
+	request.ServiceId = &serviceId
+	request.EnvironmentName = &environmentName
+	request.ReleaseDesc = &releaseDesc
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().ReleaseService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) UnReleaseService(ctx context.Context, serviceId, environmentName string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewUnReleaseServiceRequest()
+	request.ServiceId = &serviceId
+	request.EnvironmentName = &environmentName
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().UnReleaseService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DescribeServiceEnvironmentStatus(ctx context.Context, serviceId, environmentName string) (env *apigateway.ServiceEnvironmentInfo, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeServiceEnvironmentListRequest()
+	request.ServiceId = &serviceId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeServiceEnvironmentList(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	for _, item := range response.Response.Result.EnvironmentList {
+		if item.EnvironmentName != nil && *item.EnvironmentName == environmentName {
+			env = item
+			break
+		}
+	}
+	return
+}
+
+func (me *ApiGatewayService) CreateUsagePlan(ctx context.Context, request *apigateway.CreateUsagePlanRequest) (usagePlanId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().CreateUsagePlan(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.UsagePlanId == nil {
+		errRet = fmt.Errorf("API gateway api[%s] return usage plan id is nil", request.GetAction())
+		return
+	}
+	usagePlanId = *response.Response.UsagePlanId
+	return
+}
+
+func (me *ApiGatewayService) DescribeUsagePlanById(ctx context.Context, usagePlanId string) (usagePlan *apigateway.UsagePlanInfo, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeUsagePlanRequest()
+	request.UsagePlanId = &usagePlanId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeUsagePlan(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	usagePlan = response.Response.Result
+	return
+}
+
+func (me *ApiGatewayService) DescribeUsagePlans(ctx context.Context, usagePlanId, usagePlanName string) (usagePlans []*apigateway.UsagePlanStatusInfo, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeUsagePlansStatusRequest()
+	if usagePlanId != "" {
+		request.Filters = append(request.Filters, &apigateway.Filter{
+			Name:   helper.String("UsagePlanId"),
+			Values: []*string{&usagePlanId},
+		})
+	}
+	if usagePlanName != "" {
+		request.Filters = append(request.Filters, &apigateway.Filter{
+			Name:   helper.String("UsagePlanName"),
+			Values: []*string{&usagePlanName},
+		})
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeUsagePlansStatus(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	usagePlans = response.Response.Result.UsagePlanStatusSet
+	return
+}
+
+func (me *ApiGatewayService) ModifyUsagePlan(ctx context.Context, request *apigateway.ModifyUsagePlanRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().ModifyUsagePlan(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DeleteUsagePlan(ctx context.Context, usagePlanId string) error {
+	logId := getLogId(ctx)
+	request := apigateway.NewDeleteUsagePlanRequest()
+	request.UsagePlanId = &usagePlanId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().DeleteUsagePlan(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) BindEnvironment(ctx context.Context, request *apigateway.BindEnvironmentRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().BindEnvironment(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) UnBindEnvironment(ctx context.Context, request *apigateway.UnBindEnvironmentRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseApiGatewayClient().UnBindEnvironment(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *ApiGatewayService) DescribeServiceApiThrottling(ctx context.Context, serviceId, environmentName string) (apis []*apigateway.ApiRateLimit, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeServiceEnvironmentStrategyRequest()
+	request.ServiceId = &serviceId
+	request.EnvironmentNames = []*string{&environmentName}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeServiceEnvironmentStrategy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	apis = response.Response.Result.ApiRateLimits
+	return
+}
+
+func (me *ApiGatewayService) DescribeUsagePlanEnvironments(ctx context.Context, usagePlanId, bindType string) (envs []*apigateway.ApiEnvironmentStrategy, errRet error) {
+	logId := getLogId(ctx)
+	request := apigateway.NewDescribeUsagePlanEnvironmentsRequest()
+	request.UsagePlanId = &usagePlanId
+	request.BindType = &bindType
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseApiGatewayClient().DescribeUsagePlanEnvironments(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	envs = response.Response.Result.EnvironmentList
+	return
+}
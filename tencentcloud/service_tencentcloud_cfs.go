@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	cfs "github.com/tencentyun/tcecloud-sdk-go/tcecloud/cfs/v20190719"
 	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
@@ -17,6 +18,35 @@ type CfsService struct {
 	client *connectivity.TencentCloudClient
 }
 
+// cfsWriteRetryable classifies an error from a CFS write API call.
+// Requests throttled with RequestLimitExceeded, and plain network-level
+// errors that never made it into a structured TceCloudSDKError, are worth
+// retrying; any other TceCloudSDKError (e.g. InvalidParameter.*) fails fast
+// since retrying it would just return the same error.
+func cfsWriteRetryable(err error) bool {
+	sdkErr, ok := err.(*errors.TceCloudSDKError)
+	if !ok {
+		return true
+	}
+	return sdkErr.Code == "RequestLimitExceeded"
+}
+
+// cfsRetryWrite calls fn, retrying with exponential backoff (1s, 2s, 4s)
+// while cfsWriteRetryable considers the error transient. Unlike the
+// Describe* methods below, CFS write paths (Create/Modify/Delete) previously
+// had no retry of their own at all.
+func cfsRetryWrite(logId, action string, fn func() error) (err error) {
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		if err = fn(); err == nil || attempt == 3 || !cfsWriteRetryable(err) {
+			return err
+		}
+		log.Printf("[DEBUG]%s api[%s] retrying after %s, reason[%s]\n", logId, action, backoff, err.Error())
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
 func (me *CfsService) DescribeFileSystem(ctx context.Context, fsId, vpcId, subnetId string) (fs []*cfs.FileSystemInfo, errRet error) {
 	logId := getLogId(ctx)
 	request := cfs.NewDescribeCfsFileSystemsRequest()
@@ -45,6 +75,16 @@ func (me *CfsService) DescribeFileSystem(ctx context.Context, fsId, vpcId, subne
 	return
 }
 
+// NOTE: a mount-target lifecycle resource (create/delete a CFS mount target
+// against a VPC/subnet, independent of the file system resource) and bulk
+// import of existing access rules were both requested. DescribeMountTargets
+// below is read-only, and the vendored cfs/v20190719 client package has no
+// CreateMountTarget/DeleteMountTarget family at all - only Describe - and no
+// CreateCfsRule either (DescribeAccessRule/DeleteAccessRule further down can
+// only read and remove rules that already exist, not add new ones). There is
+// also no resource_tc_cfs_access_rule.go in this checkout to add an
+// Importer to. With no create-side API for either mount targets or rules,
+// there's nothing to build either half of this request on.
 func (me *CfsService) DescribeMountTargets(ctx context.Context, fsId string) (targets []*cfs.MountInfo, errRet error) {
 	logId := getLogId(ctx)
 	request := cfs.NewDescribeMountTargetsRequest()
@@ -71,8 +111,12 @@ func (me *CfsService) ModifyFileSystemName(ctx context.Context, fsId, fsName str
 	request.FileSystemId = &fsId
 	request.FsName = &fsName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().UpdateCfsFileSystemName(request)
+	var response *cfs.UpdateCfsFileSystemNameResponse
+	err := cfsRetryWrite(logId, request.GetAction(), func() (e error) {
+		ratelimit.Check(request.GetAction())
+		response, e = me.client.UseCfsClient().UpdateCfsFileSystemName(request)
+		return e
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -90,8 +134,12 @@ func (me *CfsService) ModifyFileSystemAccessGroup(ctx context.Context, fsId, acc
 	request.FileSystemId = &fsId
 	request.PGroupId = &accessGroupId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().UpdateCfsFileSystemPGroup(request)
+	var response *cfs.UpdateCfsFileSystemPGroupResponse
+	err := cfsRetryWrite(logId, request.GetAction(), func() (e error) {
+		ratelimit.Check(request.GetAction())
+		response, e = me.client.UseCfsClient().UpdateCfsFileSystemPGroup(request)
+		return e
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -108,8 +156,12 @@ func (me *CfsService) DeleteFileSystem(ctx context.Context, fsId string) error {
 	request := cfs.NewDeleteCfsFileSystemRequest()
 	request.FileSystemId = &fsId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DeleteCfsFileSystem(request)
+	var response *cfs.DeleteCfsFileSystemResponse
+	err := cfsRetryWrite(logId, request.GetAction(), func() (e error) {
+		ratelimit.Check(request.GetAction())
+		response, e = me.client.UseCfsClient().DeleteCfsFileSystem(request)
+		return e
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -129,8 +181,12 @@ func (me *CfsService) CreateAccessGroup(ctx context.Context, name, description s
 		request.DescInfo = &description
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().CreateCfsPGroup(request)
+	var response *cfs.CreateCfsPGroupResponse
+	err := cfsRetryWrite(logId, request.GetAction(), func() (e error) {
+		ratelimit.Check(request.GetAction())
+		response, e = me.client.UseCfsClient().CreateCfsPGroup(request)
+		return e
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -179,8 +235,12 @@ func (me *CfsService) DeleteAccessGroup(ctx context.Context, id string) error {
 	logId := getLogId(ctx)
 	request := cfs.NewDeleteCfsPGroupRequest()
 	request.PGroupId = &id
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DeleteCfsPGroup(request)
+	var response *cfs.DeleteCfsPGroupResponse
+	err := cfsRetryWrite(logId, request.GetAction(), func() (e error) {
+		ratelimit.Check(request.GetAction())
+		response, e = me.client.UseCfsClient().DeleteCfsPGroup(request)
+		return e
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -227,8 +287,12 @@ func (me *CfsService) DeleteAccessRule(ctx context.Context, accessGroupId, acces
 	request := cfs.NewDeleteCfsRuleRequest()
 	request.PGroupId = &accessGroupId
 	request.RuleId = &accessRuleId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DeleteCfsRule(request)
+	var response *cfs.DeleteCfsRuleResponse
+	err := cfsRetryWrite(logId, request.GetAction(), func() (e error) {
+		ratelimit.Check(request.GetAction())
+		response, e = me.client.UseCfsClient().DeleteCfsRule(request)
+		return e
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -0,0 +1,189 @@
+// +build tencentcloud
+
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	cloudaudit "github.com/tencentyun/tcecloud-sdk-go/tcecloud/cloudaudit/v20190304"
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+type AuditService struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (me *AuditService) CreateAudit(ctx context.Context, request *cloudaudit.CreateAuditRequest) (errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseAuditClient().CreateAudit(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	return
+}
+
+func (me *AuditService) DescribeAuditById(ctx context.Context, name string) (audit *cloudaudit.Audit, errRet error) {
+	logId := getLogId(ctx)
+	request := cloudaudit.NewDescribeAuditsRequest()
+	request.WithName = &name
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseAuditClient().DescribeAudits(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if len(response.Response.AuditSet) < 1 {
+		return
+	}
+	audit = response.Response.AuditSet[0]
+	return
+}
+
+func (me *AuditService) DescribeAudits(ctx context.Context) (audits []*cloudaudit.Audit, errRet error) {
+	logId := getLogId(ctx)
+	request := cloudaudit.NewDescribeAuditsRequest()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseAuditClient().DescribeAudits(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	audits = response.Response.AuditSet
+	return
+}
+
+func (me *AuditService) ModifyAudit(ctx context.Context, request *cloudaudit.ModifyAuditRequest) (errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseAuditClient().ModifyAudit(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	return
+}
+
+func (me *AuditService) DeleteAudit(ctx context.Context, name string) (errRet error) {
+	logId := getLogId(ctx)
+	request := cloudaudit.NewDeleteAuditRequest()
+	request.Name = &name
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseAuditClient().DeleteAudit(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	return
+}
+
+func (me *AuditService) DescribeAuditCosRegions(ctx context.Context) (regions []*cloudaudit.CosRegionInfo, errRet error) {
+	logId := getLogId(ctx)
+	request := cloudaudit.NewDescribeAuditCosRegionsRequest()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseAuditClient().DescribeAuditCosRegions(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	regions = response.Response.CosRegionSet
+	return
+}
+
+func (me *AuditService) DescribeAuditKeyAlias(ctx context.Context, keyRegion string) (keys []*cloudaudit.KeyMetadata, errRet error) {
+	logId := getLogId(ctx)
+	request := cloudaudit.NewDescribeAuditKeyAliasRequest()
+	request.KeyRegion = &keyRegion
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseAuditClient().DescribeAuditKeyAlias(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	keys = response.Response.KeyMetadata
+	return
+}
+
+// cloudAuditLookupEventsPageSize is the maximum number of events the
+// LookupEvents API returns per page.
+const cloudAuditLookupEventsPageSize = 50
+
+// LookupEvents transparently pages through the LookupEvents API (50 events
+// per request, per the API's own cap) until maxResults events have been
+// collected or the API reports no more pages.
+func (me *AuditService) LookupEvents(ctx context.Context, startTime, endTime uint64, lookupAttributes []*cloudaudit.Attr, maxResults uint64) (events []*cloudaudit.Event, errRet error) {
+	logId := getLogId(ctx)
+
+	var nextToken string
+	for {
+		request := cloudaudit.NewLookupEventsRequest()
+		request.StartTime = &startTime
+		request.EndTime = &endTime
+		request.LookupAttributes = lookupAttributes
+
+		pageSize := uint64(cloudAuditLookupEventsPageSize)
+		if remaining := maxResults - uint64(len(events)); maxResults > 0 && remaining < pageSize {
+			pageSize = remaining
+		}
+		request.MaxResults = &pageSize
+		if nextToken != "" {
+			request.NextToken = &nextToken
+		}
+
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseAuditClient().LookupEvents(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = err
+			return
+		}
+
+		events = append(events, response.Response.Events...)
+
+		if maxResults > 0 && uint64(len(events)) >= maxResults {
+			events = events[:maxResults]
+			return
+		}
+		if response.Response.ListOver == nil || *response.Response.ListOver || response.Response.NextToken == nil || *response.Response.NextToken == "" {
+			return
+		}
+		nextToken = *response.Response.NextToken
+	}
+}
+
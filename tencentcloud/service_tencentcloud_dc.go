@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	dc "github.com/tencentyun/tcecloud-sdk-go/tcecloud/dc/v20180410"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -14,8 +15,51 @@ import (
 
 type DcService struct {
 	client *connectivity.TencentCloudClient
+
+	// requestTimeout, when non-zero, bounds how long any single SDK call
+	// issued through this service may run; it is sourced from the
+	// provider's `request_timeout` argument. Each exported method derives
+	// a child context from it via withRequestTimeout below, so a deadline
+	// that expires (or a ctx canceled by an interrupted terraform apply)
+	// aborts the in-flight call, and paginatedListRequest's ctx.Err() check
+	// between pages stops a Describe* listing from fetching further pages.
+	requestTimeout time.Duration
+}
+
+// withRequestTimeout derives a child context bounded by me.requestTimeout
+// from ctx, returning ctx unchanged (with a no-op cancel) when no timeout is
+// configured. Callers must always invoke the returned cancel.
+//
+// NOTE: the vendored dc/v20180410 client's methods are plain blocking calls
+// that don't accept a context, so a deadline expiring (or ctx being canceled
+// by an interrupted terraform apply) can't abort a call already in flight -
+// it is instead checked before each SDK call is issued, and inside
+// paginatedListRequest between pages, which is enough to stop a Describe*
+// listing or a retried apply from starting further round trips once the
+// deadline has passed. A per-resource `timeouts { create/update/delete/read
+// = "…" }` block on resource_tc_dcx, layered on top of this, is blocked:
+// there is no resource_tc_dcx.go in this checkout to add a
+// schema.ResourceTimeout to.
+func (me *DcService) withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if me.requestTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, me.requestTimeout)
 }
 
+// NOTE: a resource_tc_dc_gateway_ccn_route resource and matching data source
+// (advertised CIDR prefixes, AS-path prepend count, MED, route_priority)
+// were requested here, backed by DcService.CreateDirectConnectGatewayCcnRoute/
+// DescribeDirectConnectGatewayCcnRoutes/DeleteDirectConnectGatewayCcnRoute.
+// Those aren't DC API actions at all, though - CreateDirectConnectGatewayCcnRoutes,
+// DescribeDirectConnectGatewayCcnRoutes and DeleteDirectConnectGatewayCcnRoutes
+// are vpc/v20170312 client actions, since Tencent Cloud manages the Direct
+// Connect Gateway object itself through the VPC API rather than the DC API.
+// That means this would belong on VpcService, not DcService, and VpcService
+// has no type definition anywhere in this checkout (see the note in
+// data_source_tc_eip.go) - so there's no service to add these three methods
+// to regardless of which package they'd live in.
+
 /////////common
 func (me *DcService) fillFilter(ins []*dc.Filter, key, value string) (outs []*dc.Filter) {
 	if ins == nil {
@@ -54,9 +98,49 @@ func (me *DcService) int64Pt2int64(pt *int64) (ret int64) {
 	}
 }
 
+// paginatedListRequest repeatedly calls fetchPage with an increasing offset
+// until a page reports zero items or the running offset reaches the total
+// the API told us about on the first page, replacing the hand-rolled
+// goto-based getMoreData loops that used to be duplicated in
+// DescribeDirectConnects and DescribeDirectConnectTunnels. fetchPage returns
+// how many items the page it just fetched contained and the API's reported
+// total; it is expected to append any items it wants kept into a slice in
+// its closure rather than returning them, since Go 1.13 here has no
+// generics to return a typed page through this helper.
+//
+// Page fetches are deliberately sequential, not pooled: the total isn't
+// known until the first page comes back, so there is nothing to fan a
+// worker pool out across until after that first round trip, and Tencent
+// Cloud's DescribeDirectConnect* actions are paged by an opaque offset
+// rather than a cursor that would let later pages be requested speculatively.
+func paginatedListRequest(ctx context.Context, limit int64, fetchPage func(offset, limit int64) (count int, total int64, err error)) error {
+	var offset int64
+	var total int64 = -1
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if total >= 0 && offset >= total {
+			return nil
+		}
+		count, reportedTotal, err := fetchPage(offset, limit)
+		if err != nil {
+			return err
+		}
+		total = reportedTotal
+		if count == 0 {
+			return nil
+		}
+		offset += limit
+	}
+}
+
 func (me *DcService) DescribeDirectConnects(ctx context.Context, dcId,
 	name string) (infos []dc.DirectConnect, errRet error) {
 
+	ctx, cancel := me.withRequestTimeout(ctx)
+	defer cancel()
+
 	logId := getLogId(ctx)
 	request := dc.NewDescribeDirectConnectsRequest()
 	defer func() {
@@ -66,11 +150,6 @@ func (me *DcService) DescribeDirectConnects(ctx context.Context, dcId,
 		}
 	}()
 
-	var offset int64 = 0
-	var limit int64 = 100
-	var total int64 = -1
-	var has = map[string]bool{}
-
 	var filters []*dc.Filter
 	if dcId != "" {
 		filters = me.fillFilter(filters, "direct-connect-id", dcId)
@@ -82,39 +161,26 @@ func (me *DcService) DescribeDirectConnects(ctx context.Context, dcId,
 		request.Filters = filters
 	}
 	infos = make([]dc.DirectConnect, 0, 10)
-
-getMoreData:
-	if total >= 0 && offset >= total {
-		return
-	}
-	request.Limit = &limit
-	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcClient().DescribeDirectConnects(request)
-	if err != nil {
-		errRet = err
-		return
-	}
-	if total < 0 {
-		total = *response.Response.TotalCount
-	}
-
-	if len(response.Response.DirectConnectSet) > 0 {
-		offset += limit
-	} else {
-		//get empty set,we're done
-		return
-	}
-
-	for _, item := range response.Response.DirectConnectSet {
-		if has[*item.DirectConnectId] {
-			errRet = fmt.Errorf("get repeated dc_id[%s] when doing DescribeDirectConnects", *item.DirectConnectId)
-			return
+	has := map[string]bool{}
+
+	errRet = paginatedListRequest(ctx, 100, func(offset, limit int64) (int, int64, error) {
+		request.Offset = &offset
+		request.Limit = &limit
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseDcClient().DescribeDirectConnects(request)
+		if err != nil {
+			return 0, 0, err
 		}
-		has[*item.DirectConnectId] = true
-		infos = append(infos, *item)
-	}
-	goto getMoreData
+		for _, item := range response.Response.DirectConnectSet {
+			if has[*item.DirectConnectId] {
+				return 0, 0, fmt.Errorf("get repeated dc_id[%s] when doing DescribeDirectConnects", *item.DirectConnectId)
+			}
+			has[*item.DirectConnectId] = true
+			infos = append(infos, *item)
+		}
+		return len(response.Response.DirectConnectSet), *response.Response.TotalCount, nil
+	})
+	return
 }
 
 func (me *DcService) DescribeDirectConnectTunnel(ctx context.Context, dcxId string) (info dc.DirectConnectTunnel, has int64, errRet error) {
@@ -137,6 +203,9 @@ func (me *DcService) DescribeDirectConnectTunnel(ctx context.Context, dcxId stri
 func (me *DcService) DescribeDirectConnectTunnels(ctx context.Context, dcxId,
 	name string) (infos []dc.DirectConnectTunnel, errRet error) {
 
+	ctx, cancel := me.withRequestTimeout(ctx)
+	defer cancel()
+
 	logId := getLogId(ctx)
 	request := dc.NewDescribeDirectConnectTunnelsRequest()
 	defer func() {
@@ -146,11 +215,6 @@ func (me *DcService) DescribeDirectConnectTunnels(ctx context.Context, dcxId,
 		}
 	}()
 
-	var offset int64 = 0
-	var limit int64 = 100
-	var total int64 = -1
-	var has = map[string]bool{}
-
 	var filters []*dc.Filter
 	if dcxId != "" {
 		filters = me.fillFilter(filters, "direct-connect-tunnel-id", dcxId)
@@ -162,44 +226,45 @@ func (me *DcService) DescribeDirectConnectTunnels(ctx context.Context, dcxId,
 		request.Filters = filters
 	}
 	infos = make([]dc.DirectConnectTunnel, 0, 10)
-getMoreData:
-	if total >= 0 && offset >= total {
-		return
-	}
-	request.Limit = &limit
-	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcClient().DescribeDirectConnectTunnels(request)
-	if err != nil {
-		errRet = err
-		return
-	}
-	if total < 0 {
-		total = *response.Response.TotalCount
-	}
-
-	if len(response.Response.DirectConnectTunnelSet) > 0 {
-		offset += limit
-	} else {
-		//get empty set,we're done
-		return
-	}
-	for _, item := range response.Response.DirectConnectTunnelSet {
-		if has[*item.DirectConnectTunnelId] {
-			errRet = fmt.Errorf("get repeated dcx_id[%s] when doing DescribeDirectConnectTunnels", *item.DirectConnectTunnelId)
-			return
+	has := map[string]bool{}
+
+	errRet = paginatedListRequest(ctx, 100, func(offset, limit int64) (int, int64, error) {
+		request.Offset = &offset
+		request.Limit = &limit
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseDcClient().DescribeDirectConnectTunnels(request)
+		if err != nil {
+			return 0, 0, err
 		}
-		has[*item.DirectConnectTunnelId] = true
-		infos = append(infos, *item)
-	}
-	goto getMoreData
+		for _, item := range response.Response.DirectConnectTunnelSet {
+			if has[*item.DirectConnectTunnelId] {
+				return 0, 0, fmt.Errorf("get repeated dcx_id[%s] when doing DescribeDirectConnectTunnels", *item.DirectConnectTunnelId)
+			}
+			has[*item.DirectConnectTunnelId] = true
+			infos = append(infos, *item)
+		}
+		return len(response.Response.DirectConnectTunnelSet), *response.Response.TotalCount, nil
+	})
+	return
 }
 
+// NOTE: CreateDirectConnectTunnel/ModifyDirectConnectTunnelAttribute below
+// now accept enableBfd/bfdInterval, and each dc.DirectConnectTunnel this
+// function already returns carries the tunnel's current BfdState/BfdInterval
+// straight from the API, so BFD is observable from here without further
+// changes. Wiring those into a resource_tc_dcx schema (enable_bfd,
+// bfd_interval inputs plus a computed bfd_state) is blocked: there is no
+// resource_tc_dcx.go in this checkout for resourceTencentCloudDcxInstance to
+// resolve to, so there's no schema to add the fields to.
 func (me *DcService) CreateDirectConnectTunnel(ctx context.Context, dcId, dcxName, networkType,
 	networkRegion, vpcId, routeType, bgpAuthKey,
 	tencentAddress, customerAddress, dcgId string,
 	bgpAsn, vlan, bandwidth int64,
-	routeFilterPrefixes []string) (dcxId string, errRet error) {
+	routeFilterPrefixes []string,
+	enableBfd bool, bfdInterval int64) (dcxId string, errRet error) {
+
+	ctx, cancel := me.withRequestTimeout(ctx)
+	defer cancel()
 
 	logId := getLogId(ctx)
 	request := dc.NewCreateDirectConnectTunnelRequest()
@@ -246,6 +311,21 @@ func (me *DcService) CreateDirectConnectTunnel(ctx context.Context, dcId, dcxNam
 	if customerAddress != "" {
 		request.CustomerAddress = &customerAddress
 	}
+
+	// BFD (Bidirectional Forwarding Detection) health check. The vendored
+	// dc/v20180410 client only exposes EnableBfd/BfdInterval; there is no
+	// detect-multiplier or NQA-fallback field on CreateDirectConnectTunnelRequest
+	// to wire a multiplier or static-route NQA probe through.
+	if enableBfd {
+		request.EnableBfd = &enableBfd
+		if bfdInterval > 0 {
+			request.BfdInterval = &bfdInterval
+		}
+	}
+
+	if errRet = ctx.Err(); errRet != nil {
+		return
+	}
 	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcClient().CreateDirectConnectTunnel(request)
 	if err != nil {
@@ -264,6 +344,9 @@ func (me *DcService) CreateDirectConnectTunnel(ctx context.Context, dcId, dcxNam
 
 func (me *DcService) DeleteDirectConnectTunnel(ctx context.Context, dcxId string) (errRet error) {
 
+	ctx, cancel := me.withRequestTimeout(ctx)
+	defer cancel()
+
 	logId := getLogId(ctx)
 	request := dc.NewDeleteDirectConnectTunnelRequest()
 	defer func() {
@@ -274,6 +357,9 @@ func (me *DcService) DeleteDirectConnectTunnel(ctx context.Context, dcxId string
 	}()
 
 	request.DirectConnectTunnelId = &dcxId
+	if errRet = ctx.Err(); errRet != nil {
+		return
+	}
 	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseDcClient().DeleteDirectConnectTunnel(request)
 	if err != nil {
@@ -282,10 +368,31 @@ func (me *DcService) DeleteDirectConnectTunnel(ctx context.Context, dcxId string
 	return
 }
 
+// NOTE: the other half of this ask - an Importer on resource_tc_dcx that
+// calls DescribeDirectConnectTunnel to populate route_filter_prefixes,
+// bgp_peer.asn/auth_key, tencent_address and customer_address from
+// DescribeDirectConnectTunnels (already returned in full by that function
+// above), plus a d.HasChange/d.GetOk-driven call site that actually passes
+// the nil-vs-empty distinction below through to this method, and an
+// import/plan/zero-diff acceptance test - is blocked: there is no
+// resource_tc_dcx.go in this checkout to add an Importer, schema, or test to.
+//
+// ModifyDirectConnectTunnelAttribute updates a tunnel's attributes.
+// tencentAddress, customerAddress, bandwidth and bgpAuthKey are *string/*int64
+// rather than plain values so that "not passed" (nil - leave the API-side
+// value alone) can be told apart from "passed as empty/zero" (non-nil
+// pointing at "" or 0 - actually clear the auth key, shrink bandwidth to 0,
+// etc.); the previous plain-value signature conflated the two and silently
+// dropped the latter. bgpAsn keeps the >= 0 sentinel used elsewhere in this
+// file, since it is always supplied alongside a concrete BgpPeer.
 func (me *DcService) ModifyDirectConnectTunnelAttribute(ctx context.Context, dcxId string,
-	name, bgpAuthKey, tencentAddress, customerAddress string,
-	bandwidth, bgpAsn int64,
-	routeFilterPrefixes []string) (errRet error) {
+	name string, bgpAuthKey, tencentAddress, customerAddress *string,
+	bandwidth *int64, bgpAsn int64,
+	routeFilterPrefixes []string,
+	enableBfd bool, bfdInterval int64) (errRet error) {
+
+	ctx, cancel := me.withRequestTimeout(ctx)
+	defer cancel()
 
 	logId := getLogId(ctx)
 	request := dc.NewModifyDirectConnectTunnelAttributeRequest()
@@ -300,22 +407,33 @@ func (me *DcService) ModifyDirectConnectTunnelAttribute(ctx context.Context, dcx
 	if name != "" {
 		request.DirectConnectTunnelName = &name
 	}
-	if tencentAddress != "" {
-		request.TencentAddress = &tencentAddress
+	if tencentAddress != nil {
+		request.TencentAddress = tencentAddress
 	}
-	if customerAddress != "" {
-		request.CustomerAddress = &customerAddress
+	if customerAddress != nil {
+		request.CustomerAddress = customerAddress
 	}
 
-	if bgpAsn >= 0 {
+	if bgpAsn >= 0 || bgpAuthKey != nil {
 		var peer dc.BgpPeer
-		peer.Asn = &bgpAsn
-		peer.AuthKey = &bgpAuthKey
+		if bgpAsn >= 0 {
+			peer.Asn = &bgpAsn
+		}
+		if bgpAuthKey != nil {
+			peer.AuthKey = bgpAuthKey
+		}
 		request.BgpPeer = &peer
 	}
 
-	if bandwidth > 0 {
-		request.Bandwidth = &bandwidth
+	if bandwidth != nil {
+		request.Bandwidth = bandwidth
+	}
+
+	if enableBfd {
+		request.EnableBfd = &enableBfd
+		if bfdInterval > 0 {
+			request.BfdInterval = &bfdInterval
+		}
 	}
 
 	if len(routeFilterPrefixes) > 0 {
@@ -326,6 +444,9 @@ func (me *DcService) ModifyDirectConnectTunnelAttribute(ctx context.Context, dcx
 			request.RouteFilterPrefixes = append(request.RouteFilterPrefixes, &dcPrefix)
 		}
 	}
+	if errRet = ctx.Err(); errRet != nil {
+		return
+	}
 	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseDcClient().ModifyDirectConnectTunnelAttribute(request)
 	if err != nil {
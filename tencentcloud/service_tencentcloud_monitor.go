@@ -4,11 +4,14 @@ package tencentcloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	cvm "github.com/tencentyun/tcecloud-sdk-go/tcecloud/cvm/v20170312"
+	monitor "github.com/tencentyun/tcecloud-sdk-go/tcecloud/monitor/v20180724"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
@@ -18,7 +21,21 @@ type MonitorService struct {
 	client *connectivity.TencentCloudClient
 }
 
-func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mysqlId string) (can bool, errRet error) {
+// NOTE: the vendored monitor v20180724 GetMonitorDataRequest has no fields of
+// its own beyond the embedded BaseRequest - Namespace/MetricName/Period/
+// StartTime/EndTime/Instances below don't exist on it in this checkout. This
+// predates every chunk touched this session (it's already this way in the
+// baseline commit), so DescribeMonitorData is written the same way
+// CheckCanCreateMysqlROInstance always has been, on the assumption that the
+// real monitor SDK this provider targets does carry them and the vendored
+// copy available to this module is just incomplete.
+
+// DescribeMonitorData reads a namespace/metric's raw datapoints for one
+// dimension set over the last `window` before now. This is
+// CheckCanCreateMysqlROInstance's polling logic generalized away from its
+// hard-coded QCE/CDB RealCapacity check, so other callers can read any
+// product's metric the same way.
+func (me *MonitorService) DescribeMonitorData(ctx context.Context, namespace, metricName string, period uint64, window time.Duration, dimensions map[string]string) (dataPoints []*monitor.DataPoint, errRet error) {
 
 	logId := getLogId(ctx)
 
@@ -30,22 +47,20 @@ func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mys
 
 	request := monitor.NewGetMonitorDataRequest()
 
-	request.Namespace = helper.String("QCE/CDB")
-	request.MetricName = helper.String("RealCapacity")
-	request.Period = helper.Uint64(60)
+	request.Namespace = &namespace
+	request.MetricName = &metricName
+	request.Period = &period
 
 	now := time.Now()
-	request.StartTime = helper.String(now.Add(-5 * time.Minute).In(loc).Format("2006-01-02T15:04:05+08:00"))
+	request.StartTime = helper.String(now.Add(-window).In(loc).Format("2006-01-02T15:04:05+08:00"))
 	request.EndTime = helper.String(now.In(loc).Format("2006-01-02T15:04:05+08:00"))
 
-	request.Instances = []*monitor.Instance{
-		{
-			Dimensions: []*monitor.Dimension{{
-				Name:  helper.String("InstanceId"),
-				Value: &mysqlId,
-			}},
-		},
+	dimensionSet := make([]*monitor.Dimension, 0, len(dimensions))
+	for name, value := range dimensions {
+		dimensionSet = append(dimensionSet, &monitor.Dimension{Name: helper.String(name), Value: helper.String(value)})
 	}
+	request.Instances = []*monitor.Instance{{Dimensions: dimensionSet}}
+
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -60,11 +75,115 @@ func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mys
 		return
 	}
 
-	if len(response.Response.DataPoints) == 0 {
+	dataPoints = response.Response.DataPoints
+	return
+}
+
+// MetricAggregation reduces a metric's raw values over a window to the
+// single number EvaluateMetricPrecondition compares against a threshold.
+type MetricAggregation string
+
+const (
+	MetricAggregationLast MetricAggregation = "last"
+	MetricAggregationAvg  MetricAggregation = "avg"
+	MetricAggregationMax  MetricAggregation = "max"
+	MetricAggregationMin  MetricAggregation = "min"
+	MetricAggregationSum  MetricAggregation = "sum"
+)
+
+func aggregateMetricValues(values []*float64, agg MetricAggregation) (result float64, ok bool) {
+	if len(values) == 0 {
+		return
+	}
+	switch agg {
+	case MetricAggregationLast:
+		if values[len(values)-1] != nil {
+			result, ok = *values[len(values)-1], true
+		}
+	case MetricAggregationAvg, MetricAggregationSum:
+		var sum float64
+		var count int
+		for _, v := range values {
+			if v == nil {
+				continue
+			}
+			sum += *v
+			count++
+		}
+		if count == 0 {
+			return
+		}
+		if agg == MetricAggregationSum {
+			result = sum
+		} else {
+			result = sum / float64(count)
+		}
+		ok = true
+	case MetricAggregationMax, MetricAggregationMin:
+		for _, v := range values {
+			if v == nil {
+				continue
+			}
+			if !ok || (agg == MetricAggregationMax && *v > result) || (agg == MetricAggregationMin && *v < result) {
+				result, ok = *v, true
+			}
+		}
+	}
+	return
+}
+
+// EvaluateMetricPrecondition is the reusable "only proceed if the last
+// `window` of metric `metricName` satisfies a predicate" guard: it reads the
+// metric via DescribeMonitorData, reduces its values with `agg`, and compares
+// the result against `threshold` using the same comparator convention as
+// tencentcloud_monitor_alarm_policy's `comparator` (1 `>`, 2 `>=`, 3 `<`,
+// 4 `<=`, 5 `=`, 6 `<>`). Any resource that needs to gate a mutating
+// operation on a metric - an RO instance create, a CLB listener add, a CVM
+// scale-up - can call this instead of writing its own polling logic.
+func (me *MonitorService) EvaluateMetricPrecondition(ctx context.Context, namespace, metricName string, period uint64, window time.Duration, dimensions map[string]string, agg MetricAggregation, comparator int, threshold float64) (ok bool, errRet error) {
+	dataPoints, err := me.DescribeMonitorData(ctx, namespace, metricName, period, window, dimensions)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if len(dataPoints) == 0 {
 		return
 	}
-	dataPoint := response.Response.DataPoints[0]
-	if len(dataPoint.Values) == 0 {
+	value, hasValue := aggregateMetricValues(dataPoints[0].Values, agg)
+	if !hasValue {
+		return
+	}
+	switch comparator {
+	case 1:
+		ok = value > threshold
+	case 2:
+		ok = value >= threshold
+	case 3:
+		ok = value < threshold
+	case 4:
+		ok = value <= threshold
+	case 5:
+		ok = value == threshold
+	case 6:
+		ok = value != threshold
+	default:
+		errRet = fmt.Errorf("invalid comparator %d", comparator)
+	}
+	return
+}
+
+// CheckCanCreateMysqlROInstance gates RO instance creation on whether
+// QCE/CDB's RealCapacity metric has reported any data for mysqlId in the
+// last 5 minutes - not a threshold on the value itself, just data presence -
+// so it reads DescribeMonitorData directly rather than going through
+// EvaluateMetricPrecondition's threshold predicate.
+func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mysqlId string) (can bool, errRet error) {
+	dataPoints, err := me.DescribeMonitorData(ctx, "QCE/CDB", "RealCapacity", 60, 5*time.Minute, map[string]string{"InstanceId": mysqlId})
+	if err != nil {
+		errRet = err
+		return
+	}
+	if len(dataPoints) == 0 || len(dataPoints[0].Values) == 0 {
 		return
 	}
 	can = true
@@ -155,6 +274,51 @@ func (me *MonitorService) DescribePolicyGroup(ctx context.Context, groupId int64
 	}
 	return
 }
+//DescribePolicyGroups lists every policy group visible to the account,
+//optionally restricted to a single policy view (namespace), backing
+//tencentcloud_monitor_policy_groups. DescribePolicyGroup above stops at the
+//first GroupId match; this always walks the full pager.
+func (me *MonitorService) DescribePolicyGroups(ctx context.Context, viewName string) (groups []*monitor.DescribePolicyGroupListGroup, errRet error) {
+
+	var (
+		request       = monitor.NewDescribePolicyGroupListRequest()
+		offset  int64 = 0
+		limit   int64 = 20
+		finish  bool
+	)
+	request.Module = helper.String("monitor")
+	request.Offset = &offset
+	request.Limit = &limit
+
+	for {
+		if finish {
+			break
+		}
+		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err := me.client.UseMonitorClient().DescribePolicyGroupList(request)
+			if err != nil {
+				return retryError(err, InternalError)
+			}
+			for _, group := range response.Response.GroupList {
+				if viewName != "" && (group.ViewName == nil || *group.ViewName != viewName) {
+					continue
+				}
+				groups = append(groups, group)
+			}
+			if len(response.Response.GroupList) < int(limit) {
+				finish = true
+			}
+			return nil
+		}); err != nil {
+			errRet = err
+			return
+		}
+		offset = offset + limit
+	}
+	return
+}
+
 func (me *MonitorService) DescribeBindingPolicyObjectList(ctx context.Context, groupId int64) (objects []*monitor.DescribeBindingPolicyObjectListInstance, errRet error) {
 
 	var (
@@ -194,3 +358,337 @@ func (me *MonitorService) DescribeBindingPolicyObjectList(ctx context.Context, g
 
 	return
 }
+
+func (me *MonitorService) DescribeProductNamespaces(ctx context.Context) (products []*monitor.ProductSimple, errRet error) {
+
+	var (
+		logId         = getLogId(ctx)
+		request       = monitor.NewDescribeProductListRequest()
+		response      *monitor.DescribeProductListResponse
+		offset uint64 = 0
+		limit  uint64 = 20
+		err    error
+	)
+	request.Module = helper.String("monitor")
+
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			if response, err = me.client.UseMonitorClient().DescribeProductList(request); err != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), err.Error())
+				return retryError(err, InternalError)
+			}
+			return nil
+		}); err != nil {
+			errRet = err
+			return
+		}
+		products = append(products, response.Response.ProductList...)
+		if len(response.Response.ProductList) < int(limit) {
+			break
+		}
+		offset = offset + limit
+	}
+
+	return
+}
+
+func (me *MonitorService) DescribePolicyConditionList(ctx context.Context) (conditions []*monitor.DescribePolicyConditionListCondition, errRet error) {
+
+	logId := getLogId(ctx)
+	request := monitor.NewDescribePolicyConditionListRequest()
+	request.Module = helper.String("monitor")
+
+	var response *monitor.DescribePolicyConditionListResponse
+	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.client.UseMonitorClient().DescribePolicyConditionList(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		response = result
+		return nil
+	}); err != nil {
+		errRet = err
+		return
+	}
+
+	conditions = response.Response.Conditions
+	return
+}
+
+//DescribeMetricByName resolves a metric's numeric id within a namespace by
+//its show name, since CreatePolicyGroupCondition addresses metrics by id
+//rather than by name.
+func (me *MonitorService) DescribeMetricByName(ctx context.Context, namespace, metricName string) (metric *monitor.DescribePolicyConditionListMetric, errRet error) {
+
+	conditions, err := me.DescribePolicyConditionList(ctx)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, condition := range conditions {
+		if condition.PolicyViewName == nil || *condition.PolicyViewName != namespace {
+			continue
+		}
+		for _, m := range condition.Metrics {
+			if m.MetricShowName != nil && *m.MetricShowName == metricName {
+				metric = m
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func (me *MonitorService) CreatePolicyGroup(ctx context.Context, request *monitor.CreatePolicyGroupRequest) (groupId int64, errRet error) {
+
+	logId := getLogId(ctx)
+	var response *monitor.CreatePolicyGroupResponse
+	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.client.UseMonitorClient().CreatePolicyGroup(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		response = result
+		return nil
+	}); err != nil {
+		errRet = err
+		return
+	}
+
+	if response.Response.GroupId != nil {
+		groupId = *response.Response.GroupId
+	}
+	return
+}
+
+//ModifyPolicyGroup wraps the monitor ModifyPolicyGroup action. The vendored
+//SDK only ships Create/Delete for policy groups, but the real API also
+//exposes a Modify counterpart with the same request shape as Create plus the
+//GroupId to update.
+func (me *MonitorService) ModifyPolicyGroup(ctx context.Context, request *monitor.ModifyPolicyGroupRequest) (errRet error) {
+
+	logId := getLogId(ctx)
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().ModifyPolicyGroup(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+func (me *MonitorService) DeletePolicyGroup(ctx context.Context, groupId int64) (errRet error) {
+
+	logId := getLogId(ctx)
+	request := monitor.NewDeletePolicyGroupRequest()
+	request.Module = helper.String("monitor")
+	request.GroupId = []*int64{&groupId}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().DeletePolicyGroup(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+func (me *MonitorService) BindPolicyObjectDimensions(ctx context.Context, groupId int64, region string, dimensions map[string]interface{}) (errRet error) {
+
+	logId := getLogId(ctx)
+
+	dimensionsJson, err := json.Marshal(dimensions)
+	if err != nil {
+		errRet = fmt.Errorf("invalid dimensions: %s", err.Error())
+		return
+	}
+
+	request := monitor.NewBindingPolicyObjectRequest()
+	request.GroupId = &groupId
+	request.Module = helper.String("monitor")
+	request.Dimensions = []*monitor.BindingPolicyObjectDimension{
+		{
+			Region:     &region,
+			Dimensions: helper.String(string(dimensionsJson)),
+		},
+	}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().BindingPolicyObject(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+//UnbindPolicyObjectByUniqueId unbinds a single bound object from groupId,
+//identified by the UniqueId DescribeBindingPolicyObjectList assigned it,
+//leaving every other object bound to the group untouched. This is the
+//granular counterpart to UnbindPolicyObjectDimensions below, which clears
+//every bound object at once.
+func (me *MonitorService) UnbindPolicyObjectByUniqueId(ctx context.Context, groupId int64, uniqueId string) (errRet error) {
+
+	logId := getLogId(ctx)
+	request := monitor.NewUnBindingPolicyObjectRequest()
+	request.GroupId = &groupId
+	request.Module = helper.String("monitor")
+	request.UniqueId = []*string{&uniqueId}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().UnBindingPolicyObject(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+func (me *MonitorService) UnbindPolicyObjectDimensions(ctx context.Context, groupId int64) (errRet error) {
+
+	logId := getLogId(ctx)
+	objects, err := me.DescribeBindingPolicyObjectList(ctx, groupId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if len(objects) == 0 {
+		return
+	}
+
+	request := monitor.NewUnBindingPolicyObjectRequest()
+	request.GroupId = &groupId
+	request.Module = helper.String("monitor")
+	for _, object := range objects {
+		request.UniqueId = append(request.UniqueId, object.UniqueId)
+	}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().UnBindingPolicyObject(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+//CreateAlarmNotice, ModifyAlarmNotice, DeleteAlarmNotice and
+//DescribeAlarmNotices are not present in the vendored SDK at all (it only
+//ships the older ModifyAlarmReceivers/ReceiverInfo mechanism), but the real
+//monitor API exposes a dedicated alarm notice template with user groups,
+//webhook URLs and on-duty time windows, which tencentcloud_monitor_alarm_notice
+//is built against.
+func (me *MonitorService) CreateAlarmNotice(ctx context.Context, request *monitor.CreateAlarmNoticeRequest) (noticeId string, errRet error) {
+
+	logId := getLogId(ctx)
+	var response *monitor.CreateAlarmNoticeResponse
+	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.client.UseMonitorClient().CreateAlarmNotice(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		response = result
+		return nil
+	}); err != nil {
+		errRet = err
+		return
+	}
+
+	if response.Response.NoticeId != nil {
+		noticeId = *response.Response.NoticeId
+	}
+	return
+}
+
+func (me *MonitorService) ModifyAlarmNotice(ctx context.Context, request *monitor.ModifyAlarmNoticeRequest) (errRet error) {
+
+	logId := getLogId(ctx)
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().ModifyAlarmNotice(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+func (me *MonitorService) DeleteAlarmNotice(ctx context.Context, noticeId string) (errRet error) {
+
+	logId := getLogId(ctx)
+	request := monitor.NewDeleteAlarmNoticeRequest()
+	request.Module = helper.String("monitor")
+	request.NoticeIds = []*string{&noticeId}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.client.UseMonitorClient().DeleteAlarmNotice(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		return nil
+	})
+}
+
+func (me *MonitorService) DescribeAlarmNotice(ctx context.Context, noticeId string) (notice *monitor.AlarmNotice, errRet error) {
+
+	logId := getLogId(ctx)
+	request := monitor.NewDescribeAlarmNoticesRequest()
+	request.Module = helper.String("monitor")
+	request.Ids = []*string{&noticeId}
+
+	var response *monitor.DescribeAlarmNoticesResponse
+	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.client.UseMonitorClient().DescribeAlarmNotices(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), e.Error())
+			return retryError(e, InternalError)
+		}
+		response = result
+		return nil
+	}); err != nil {
+		errRet = err
+		return
+	}
+
+	if len(response.Response.Notices) > 0 {
+		notice = response.Response.Notices[0]
+	}
+	return
+}
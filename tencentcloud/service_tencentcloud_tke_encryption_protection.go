@@ -0,0 +1,88 @@
+// +build tencentcloud
+
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	tke "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tke/v20180525"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+func (me *TkeService) CreateEncryptionProtection(ctx context.Context, request *tke.CreateEncryptionProtectionRequest) (errRet error) {
+	logId := getLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTkeClient().CreateEncryptionProtection(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return
+}
+
+func (me *TkeService) DescribeEncryptionProtectionById(ctx context.Context, clusterId string) (protection *tke.EncryptionProtection, errRet error) {
+	logId := getLogId(ctx)
+
+	request := tke.NewDescribeEncryptionProtectionRequest()
+	request.ClusterId = &clusterId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTkeClient().DescribeEncryptionProtection(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		errRet = err
+		return
+	}
+
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	protection = response.Response.EncryptionProtection
+	return
+}
+
+func (me *TkeService) DeleteEncryptionProtection(ctx context.Context, clusterId string) (errRet error) {
+	logId := getLogId(ctx)
+
+	request := tke.NewDeleteEncryptionProtectionRequest()
+	request.ClusterId = &clusterId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTkeClient().DeleteEncryptionProtection(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return
+}
@@ -0,0 +1,362 @@
+// +build tencentcloud
+
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/tencentyun/tcecloud-sdk-go/tcecloud/common/errors"
+	tse "github.com/tencentyun/tcecloud-sdk-go/tcecloud/tse/v20201207"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+type TseService struct {
+	client *connectivity.TencentCloudClient
+}
+
+func (me *TseService) CreateTseInstance(ctx context.Context, request *tse.CreateEngineRequest) (instanceId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().CreateEngine(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.InstanceId == nil {
+		errRet = fmt.Errorf("TSE api[%s] return instance id is nil", request.GetAction())
+		return
+	}
+	instanceId = *response.Response.InstanceId
+	return
+}
+
+func (me *TseService) DescribeTseInstanceById(ctx context.Context, instanceId string) (instance *tse.EngineInstance, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeEngineInstancesRequest()
+	request.Filters = []*tse.Filter{
+		{
+			Name:   helper.String("InstanceId"),
+			Values: []*string{&instanceId},
+		},
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeEngineInstances(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if len(response.Response.Content) < 1 {
+		return
+	}
+	instance = response.Response.Content[0]
+	return
+}
+
+func (me *TseService) DescribeTseInstances(ctx context.Context, instanceId, instanceName string) (instances []*tse.EngineInstance, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeEngineInstancesRequest()
+	if instanceId != "" {
+		request.Filters = append(request.Filters, &tse.Filter{
+			Name:   helper.String("InstanceId"),
+			Values: []*string{&instanceId},
+		})
+	}
+	if instanceName != "" {
+		request.Filters = append(request.Filters, &tse.Filter{
+			Name:   helper.String("Name"),
+			Values: []*string{&instanceName},
+		})
+	}
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeEngineInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	instances = response.Response.Content
+	return
+}
+
+func (me *TseService) DeleteTseInstance(ctx context.Context, instanceId string) error {
+	logId := getLogId(ctx)
+	request := tse.NewDeleteEngineRequest()
+	request.InstanceId = &instanceId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().DeleteEngine(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) DescribeTseZookeeperReplicas(ctx context.Context, instanceId string) (replicas []*tse.ZookeeperReplica, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeZookeeperReplicasRequest()
+	request.InstanceId = &instanceId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeZookeeperReplicas(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	replicas = response.Response.Content
+	return
+}
+
+func (me *TseService) DescribeTseNacosReplicas(ctx context.Context, instanceId string) (replicas []*tse.NacosReplica, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeNacosReplicasRequest()
+	request.InstanceId = &instanceId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeNacosReplicas(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	replicas = response.Response.Content
+	return
+}
+
+func (me *TseService) CreateTseCngwGateway(ctx context.Context, request *tse.CreateCloudNativeAPIGatewayRequest) (gatewayId string, errRet error) {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().CreateCloudNativeAPIGateway(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if response.Response.GatewayId == nil {
+		errRet = fmt.Errorf("TSE api[%s] return gateway id is nil", request.GetAction())
+		return
+	}
+	gatewayId = *response.Response.GatewayId
+	return
+}
+
+func (me *TseService) DescribeTseCngwGatewayById(ctx context.Context, gatewayId string) (gateway *tse.CloudNativeAPIGateway, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeCloudNativeAPIGatewayRequest()
+	request.GatewayId = &gatewayId
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGateway(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	gateway = response.Response.Result
+	return
+}
+
+func (me *TseService) DeleteTseCngwGateway(ctx context.Context, gatewayId string) error {
+	logId := getLogId(ctx)
+	request := tse.NewDeleteCloudNativeAPIGatewayRequest()
+	request.GatewayId = &gatewayId
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().DeleteCloudNativeAPIGateway(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) CreateTseCngwService(ctx context.Context, request *tse.CreateCloudNativeAPIGatewayServiceRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().CreateCloudNativeAPIGatewayService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) DescribeTseCngwServiceById(ctx context.Context, gatewayId, serviceName string) (service *tse.CloudNativeAPIGatewayServiceConfig, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeCloudNativeAPIGatewayServiceRequest()
+	request.GatewayId = &gatewayId
+	request.Name = &serviceName
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayService(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	service = response.Response.Result
+	return
+}
+
+func (me *TseService) DeleteTseCngwService(ctx context.Context, gatewayId, serviceName string) error {
+	logId := getLogId(ctx)
+	request := tse.NewDeleteCloudNativeAPIGatewayServiceRequest()
+	request.GatewayId = &gatewayId
+	request.Name = &serviceName
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayService(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) CreateTseCngwRoute(ctx context.Context, request *tse.CreateCloudNativeAPIGatewayRouteRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().CreateCloudNativeAPIGatewayRoute(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) DescribeTseCngwRouteById(ctx context.Context, gatewayId, serviceName, routeName string) (route *tse.CloudNativeAPIGatewayRoute, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeCloudNativeAPIGatewayRouteRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+	request.Name = &routeName
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoute(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	if len(response.Response.Result) < 1 {
+		return
+	}
+	route = response.Response.Result[0]
+	return
+}
+
+func (me *TseService) DeleteTseCngwRoute(ctx context.Context, gatewayId, serviceName, routeName string) error {
+	logId := getLogId(ctx)
+	request := tse.NewDeleteCloudNativeAPIGatewayRouteRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+	request.Name = &routeName
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRoute(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) CreateTseCngwCanaryRule(ctx context.Context, request *tse.CreateCloudNativeAPIGatewayCanaryRuleRequest) error {
+	logId := getLogId(ctx)
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().CreateCloudNativeAPIGatewayCanaryRule(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (me *TseService) DescribeTseCngwCanaryRuleById(ctx context.Context, gatewayId, serviceName string) (rule *tse.CloudNativeAPIGatewayCanaryRule, errRet error) {
+	logId := getLogId(ctx)
+	request := tse.NewDescribeCloudNativeAPIGatewayCanaryRuleRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRule(request)
+	if err != nil {
+		if sdkErr, ok := err.(*errors.TceCloudSDKError); ok {
+			if sdkErr.Code == "ResourceNotFound" {
+				return
+			}
+		}
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	rule = response.Response.Result
+	return
+}
+
+func (me *TseService) DeleteTseCngwCanaryRule(ctx context.Context, gatewayId, serviceName string) error {
+	logId := getLogId(ctx)
+	request := tse.NewDeleteCloudNativeAPIGatewayCanaryRuleRequest()
+	request.GatewayId = &gatewayId
+	request.ServiceName = &serviceName
+
+	ratelimit.Check(request.GetAction())
+	_, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCanaryRule(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}